@@ -0,0 +1,94 @@
+//go:build windows
+// +build windows
+
+package webview2
+
+import (
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+var (
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+// marshalResult encodes the value returned by a bound function.
+func (w *webview) marshalResult(res interface{}) ([]byte, error) {
+	// Pre-serialized JSON is passed through verbatim. It ends up in a script,
+	// so it still has to be valid.
+	if raw, ok := res.(json.RawMessage); ok {
+		if !json.Valid(raw) {
+			return nil, errors.New("webview2: bound function returned invalid JSON")
+		}
+		return raw, nil
+	}
+	if w.int64AsString && res != nil {
+		res = int64sAsStrings(reflect.ValueOf(res))
+	}
+	return json.Marshal(res)
+}
+
+// int64sAsStrings replaces the 64-bit integers in v, including those in
+// slices, arrays, maps and behind pointers, with their decimal strings. Other
+// values, structs among them, are returned as they are: their fields can use
+// the ",string" option of the json tag instead.
+func int64sAsStrings(v reflect.Value) interface{} {
+	if v.Type().Implements(jsonMarshalerType) || v.Type().Implements(textMarshalerType) {
+		return v.Interface()
+	}
+	switch v.Kind() {
+	case reflect.Int, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10)
+	case reflect.Uint, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10)
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		return int64sAsStrings(v.Elem())
+	case reflect.Slice:
+		if v.IsNil() {
+			return nil
+		}
+		// []byte is encoded as base64.
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return v.Interface()
+		}
+		fallthrough
+	case reflect.Array:
+		s := make([]interface{}, v.Len())
+		for i := range s {
+			s[i] = int64sAsStrings(v.Index(i))
+		}
+		return s
+	case reflect.Map:
+		if v.IsNil() {
+			return nil
+		}
+		m := make(map[string]interface{}, v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			m[mapKey(iter.Key())] = int64sAsStrings(iter.Value())
+		}
+		return m
+	}
+	return v.Interface()
+}
+
+// mapKey formats a map key the way encoding/json does.
+func mapKey(k reflect.Value) string {
+	if k.Kind() == reflect.String {
+		return k.String()
+	}
+	if tm, ok := k.Interface().(encoding.TextMarshaler); ok {
+		if b, err := tm.MarshalText(); err == nil {
+			return string(b)
+		}
+	}
+	return fmt.Sprint(k.Interface())
+}
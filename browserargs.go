@@ -0,0 +1,68 @@
+//go:build windows
+// +build windows
+
+package webview2
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// browserArguments holds the command line switches passed to the browser
+// process, keyed by switch name. Like custom schemes they are read when the
+// environment is created, so changes only affect webviews created later.
+var (
+	browserArguments     = map[string]string{}
+	browserArgumentsSync sync.Mutex
+)
+
+// setBrowserArgument sets the switch --name=value, or --name if value is
+// empty.
+func setBrowserArgument(name, value string) {
+	browserArgumentsSync.Lock()
+	defer browserArgumentsSync.Unlock()
+	browserArguments[name] = value
+}
+
+func removeBrowserArgument(name string) {
+	browserArgumentsSync.Lock()
+	defer browserArgumentsSync.Unlock()
+	delete(browserArguments, name)
+}
+
+func additionalBrowserArguments() string {
+	browserArgumentsSync.Lock()
+	defer browserArgumentsSync.Unlock()
+	names := make([]string, 0, len(browserArguments))
+	for name := range browserArguments {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	args := make([]string, 0, len(names))
+	for _, name := range names {
+		if value := browserArguments[name]; value != "" {
+			args = append(args, "--"+name+"="+value)
+		} else {
+			args = append(args, "--"+name)
+		}
+	}
+	return strings.Join(args, " ")
+}
+
+// EnableRemoteDebugging makes the browser process listen for the Chrome
+// DevTools Protocol on port of localhost, so that Chrome DevTools
+// (chrome://inspect), Playwright or Puppeteer can attach to the embedded
+// browser. A port <= 0 disables it again. Like SetAutoplayPolicy it must be
+// called before the webview is created.
+//
+// Any local process can control the browser through the port, so only
+// enable it for development and testing.
+func EnableRemoteDebugging(port int) {
+	if port > 0 {
+		setBrowserArgument("remote-debugging-port", strconv.Itoa(port))
+	} else {
+		removeBrowserArgument("remote-debugging-port")
+	}
+}
@@ -0,0 +1,33 @@
+//go:build windows
+// +build windows
+
+package webview2
+
+import "github.com/mzky/go-webview2/internal/w32"
+
+// Values of SetWindowDisplayAffinity.
+const (
+	wdaNone               = 0x00
+	wdaMonitor            = 0x01
+	wdaExcludeFromCapture = 0x11
+)
+
+// buildWindows10_2004 introduced WDA_EXCLUDEFROMCAPTURE.
+const buildWindows10_2004 = 19041
+
+func (w *webview) SetDisplayAffinity(exclude bool) error {
+	affinity := uintptr(wdaNone)
+	if exclude {
+		// Older versions can only black the window out instead of leaving it
+		// out of the capture.
+		affinity = wdaMonitor
+		if windowsBuild() >= buildWindows10_2004 {
+			affinity = wdaExcludeFromCapture
+		}
+	}
+	r, _, err := w32.User32SetWindowDisplayAffinity.Call(w.hWnd, affinity)
+	if r == 0 {
+		return err
+	}
+	return nil
+}
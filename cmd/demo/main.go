@@ -7,7 +7,7 @@ import (
 )
 
 func main() {
-	w := webview2.NewWithOptions(webview2.WebViewOptions{
+	w, err := webview2.NewWithOptions(webview2.WebViewOptions{
 		Debug:     true,
 		AutoFocus: true,
 		WindowOptions: webview2.WindowOptions{
@@ -18,8 +18,8 @@ func main() {
 			Center: true,
 		},
 	})
-	if w == nil {
-		log.Fatalln("Failed to load webview.")
+	if err != nil {
+		log.Fatalln("Failed to load webview:", err)
 	}
 	defer w.Destroy()
 	w.SetSize(800, 600, webview2.HintFixed)
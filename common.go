@@ -1,7 +1,14 @@
 package webview2
 
 import (
+	"context"
+	"encoding/json"
 	"github.com/lxn/win"
+	"github.com/mzky/go-webview2/pkg/edge"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"time"
 	"unsafe"
 )
 
@@ -29,7 +36,8 @@ const (
 type WebView interface {
 
 	// Run runs the main loop until it's terminated. After this function exits -
-	// you must destroy the webview.
+	// you must destroy the webview. It also returns if the message queue
+	// fails, which is logged.
 	Run()
 
 	// Terminate stops the main loop. It is safe to call this function from
@@ -56,25 +64,398 @@ type WebView interface {
 	// SetSize updates native window size. See Hint constants.
 	SetSize(w int, h int, hint Hint)
 
+	// SetSystemBackdrop sets the material drawn behind the window, such as
+	// Mica. It is only visible where the page and the webview background are
+	// transparent, see SetBackgroundColor. Requires Windows 11 22H2.
+	SetSystemBackdrop(kind Backdrop) error
+
+	// SetTitleBarColor sets the color of the window's title bar. Requires
+	// Windows 11.
+	SetTitleBarColor(r, g, b uint8) error
+
+	// SetDarkTitleBar switches the title bar between the dark and the light
+	// theme. Requires Windows 10 1809.
+	SetDarkTitleBar(dark bool) error
+
+	// SetTaskbarProgress shows the progress of a long running operation on
+	// the window's taskbar button. completed and total are ignored unless
+	// state is TaskbarNormal, TaskbarError or TaskbarPaused.
+	SetTaskbarProgress(state TaskbarState, completed, total uint64) error
+
+	// SetSessionEndHandler sets a function that is called on the UI thread
+	// when Windows is about to shut down or log off. It should save the
+	// application's state and return true; returning false asks Windows to
+	// cancel the shutdown, which the user may still override.
+	SetSessionEndHandler(fn func() bool)
+
+	// SetPowerEventHandler sets a function that is called on the UI thread
+	// when the system suspends, resumes or changes its power source, e.g. to
+	// stop timers before a suspend and reconnect after PowerResumeAutomatic.
+	SetPowerEventHandler(fn func(event PowerEvent))
+
+	// SetDisplayChangeHandler sets a function that is called on the UI thread
+	// when the display resolution or monitor layout changes. Use Monitors and
+	// MoveToMonitor from it to bring the window back on-screen.
+	SetDisplayChangeHandler(fn func())
+
+	// SetSystemThemeChangedHandler sets a function that is called on the UI
+	// thread when the user switches Windows between light and dark mode.
+	SetSystemThemeChangedHandler(fn func(isDark bool))
+
+	// Profile returns the WebView2 profile of the webview, which gives access
+	// to the profile wide settings not wrapped by this package. The caller
+	// must Release it. It fails on runtimes without ICoreWebView2_13.
+	Profile() (*edge.ICoreWebView2Profile, error)
+
+	// SetTrackingPreventionLevel sets the tracking prevention level of the
+	// profile, which applies to all webviews sharing it.
+	SetTrackingPreventionLevel(level TrackingPreventionLevel) error
+
+	// SetDefaultDownloadFolder sets the folder downloads are saved to without
+	// asking. The folder is created if it does not exist.
+	SetDefaultDownloadFolder(path string) error
+
+	// Print prints the current page. With showDialog the print preview is
+	// shown; otherwise the page is sent to the default printer directly.
+	Print(showDialog bool) error
+
+	// PrintWithSettings prints the current page without a dialog. done is
+	// called on the UI thread once the job has been handed to the printer,
+	// with an error if the printer is unavailable or printing failed.
+	PrintWithSettings(settings PrintSettings, done func(err error)) error
+
+	// SetOfflineHTML sets a page that replaces the browser error page when a
+	// navigation fails because the server cannot be reached, e.g. while the
+	// device is offline. An empty html restores the browser error page.
+	SetOfflineHTML(html string)
+
+	// FrameNames returns the names of the iframes of the current page, as set
+	// by their name attribute, in creation order.
+	FrameNames() []string
+
+	// ExecuteScriptInFrame runs js in the first iframe named frameName and
+	// returns the JSON encoded result. It waits for the main thread, so it
+	// must be called from another goroutine, not from a binding or Dispatch.
+	ExecuteScriptInFrame(frameName, js string) (string, error)
+
+	// SetWebResourceResponseReceivedHandler sets a function that is called on
+	// the UI thread with the status and headers of every HTTP response the
+	// page receives. The response cannot be modified.
+	SetWebResourceResponseReceivedHandler(fn func(uri string, status int, headers map[string]string))
+
+	// SetUnresponsiveHandler sets functions that are called on the UI thread
+	// when the page stops responding for several seconds, e.g. to show a
+	// "page not responding" overlay, and when it responds again. Passing nil
+	// for both stops monitoring the page.
+	SetUnresponsiveHandler(enter, leave func())
+
+	// SetCookieChangedHandler sets a function that is called on the UI thread
+	// when a cookie sent to uri is added, changed or deleted. The runtime has
+	// no cookie events, so the cookies are polled every
+	// WebViewOptions.CookiePollInterval. A nil fn stops watching uri.
+	SetCookieChangedHandler(uri string, fn func(c Cookie, deleted bool))
+
+	// SetContextMenuItemsToRemove removes the items with the given names,
+	// e.g. "saveAs", "print" or "inspectElement", from the default context
+	// menus. It doesn't enable the menus, which are off unless Debug is set
+	// or SetContextMenusEnabled turned them on. An empty list removes
+	// nothing.
+	SetContextMenuItemsToRemove(names []string) error
+
+	// SetContextMenusEnabled shows or hides the default context menu. Unlike
+	// the Debug option it can be changed at any time and applies to the next
+	// right click, e.g. to offer the menu only on trusted origins after each
+	// navigation. It may be called from any goroutine.
+	SetContextMenusEnabled(enabled bool) error
+
+	// WaitForSelector waits until the current document contains an element
+	// matching the CSS selector, or returns ErrWaitTimeout after timeout. It
+	// must be called from another goroutine than the main thread.
+	WaitForSelector(selector string, timeout time.Duration) error
+
+	// SupportedFeatures reports which optional capabilities the installed
+	// WebView2 runtime provides, so that an application can hide the UI for
+	// unsupported features up front. The result is probed once and cached.
+	// Methods called anyway return an error matching
+	// edge.ErrFeatureUnavailable.
+	SupportedFeatures() FeatureSet
+
+	// SetResizable allows or prevents resizing and maximizing the window
+	// without changing its current size.
+	SetResizable(resizable bool)
+
 	// Navigate navigates webview to the given URL. URL may be a data URI, i.e.
 	// "data:text/text,<html>...</html>". It is often ok not to url-encode it
 	// properly, webview will re-encode it for you.
 	Navigate(url string)
 
+	// NavigateContext navigates to url and waits until the navigation has
+	// completed. A failed navigation returns a *NavigationError. If ctx is
+	// done first, the navigation is stopped and ctx.Err() is returned. It
+	// must be called from another goroutine than the main thread.
+	NavigateContext(ctx context.Context, url string) error
+
+	// NavigateFile navigates webview to a local file. The path must be absolute,
+	// e.g. C:\app\index.html, and is converted to a properly escaped file:///
+	// URL. An error is returned if the file does not exist.
+	NavigateFile(path string) error
+
+	// UserDataFolder returns the user data folder used by the WebView2 runtime,
+	// which is DataPath or the default derived from the executable name.
+	UserDataFolder() string
+
+	// RuntimeVersion returns the version of the WebView2 runtime this window
+	// actually runs on, e.g. "118.0.2088.46". Unlike
+	// webviewloader.GetInstalledVersion it is read from the live environment,
+	// so it is also correct when a fixed version runtime is used.
+	RuntimeVersion() string
+
+	// SetControllerVisible shows or hides the WebView2 control without touching
+	// the native window. A hidden control stays alive in the background, so
+	// several webviews hosted in one window can be swapped like tabs.
+	SetControllerVisible(visible bool) error
+
+	// SetControllerBounds places the WebView2 control at the given rectangle of
+	// the window's client area instead of filling all of it, e.g. for split-pane
+	// layouts in an existing window. A zero width or height restores the
+	// default of following the client area on resize.
+	SetControllerBounds(x, y, width, height int)
+
 	// SetHtml sets the webview HTML directly.
 	// The origin of the page is `about:blank`.
 	SetHtml(html string)
 
+	// SetPasswordAutosaveEnabled controls whether the browser offers to save
+	// passwords entered in the page.
+	SetPasswordAutosaveEnabled(enabled bool) error
+
+	// SetGeneralAutofillEnabled controls whether the browser offers autofill
+	// suggestions for form fields such as addresses.
+	SetGeneralAutofillEnabled(enabled bool) error
+
+	// SetScriptEnabled controls whether JavaScript runs in the page. The
+	// setting takes effect with the next navigation; the page currently
+	// loaded keeps its scripts running. Scripts passed to Eval and Init are
+	// not affected.
+	SetScriptEnabled(enabled bool) error
+
+	// SetWebMessageEnabled controls whether pages can post messages to the
+	// host. While disabled, window.chrome.webview.postMessage and with it
+	// window.external.invoke and every function registered with Bind are
+	// unavailable to the page. Disable it before navigating to untrusted
+	// content and enable it again before navigating back to your own pages;
+	// like SetScriptEnabled it applies from the next navigation.
+	SetWebMessageEnabled(enabled bool) error
+
+	// SetHostObjectsAllowed controls whether pages can access host objects
+	// through window.chrome.webview.hostObjects.
+	SetHostObjectsAllowed(allowed bool) error
+
+	// SetZoomControlEnabled controls whether the user can change the zoom
+	// level with Ctrl+wheel, Ctrl+plus and Ctrl+minus. With false the page
+	// keeps its current zoom level.
+	SetZoomControlEnabled(enabled bool) error
+
+	// SetPinchZoomEnabled controls whether the page can be scaled with a
+	// pinch gesture on touch screens and precision touchpads. Unlike
+	// SetZoomControlEnabled it doesn't change the zoom level but only
+	// magnifies the visible part of the page.
+	SetPinchZoomEnabled(enabled bool) error
+
+	// SetSwipeNavigationEnabled controls whether swiping left or right on a
+	// touch screen or precision touchpad navigates back and forward in the
+	// history. Single-page applications usually want to disable it.
+	SetSwipeNavigationEnabled(enabled bool) error
+
+	// SetAllowExternalDrop controls whether files, text and links dragged
+	// from other applications can be dropped into the page, e.g. onto an
+	// <input type=file> or a drop zone. Without it a drop may do nothing.
+	SetAllowExternalDrop(allow bool) error
+
+	// SetFaviconChangedHandler sets a function that receives the page's
+	// favicon as PNG bytes whenever it changes. The icon is nil when the page
+	// has no favicon. Requires a WebView2 runtime with ICoreWebView2_15.
+	SetFaviconChangedHandler(fn func(iconBytes []byte, mime string))
+
+	// SetDOMContentLoadedHandler sets a function that is called when the DOM
+	// of a top-level document has been parsed, before images and other
+	// subresources have finished loading.
+	SetDOMContentLoadedHandler(fn func())
+
+	// SetStatusBarTextChangedHandler sets a function that is called with the
+	// status bar text, e.g. the URL of a hovered link, whenever it changes.
+	// The built-in status bar is hidden while a handler is set, so the
+	// application can show the text itself.
+	SetStatusBarTextChangedHandler(fn func(text string))
+
+	// SetDocumentTitleChangedHandler sets a function that is called on the
+	// main thread whenever the title of the top-level document changes.
+	SetDocumentTitleChangedHandler(fn func(title string))
+
+	// SetTitleFormat keeps the window title in sync with the document title.
+	// The first %s in format is replaced by the document title, e.g.
+	// "%s - MyApp"; titles longer than 80 characters are shortened. An empty
+	// format stops updating the window title.
+	SetTitleFormat(format string)
+
+	// RestrictToOrigin cancels every top-level navigation that leaves origin,
+	// e.g. "https://example.com". If openExternal is true, cancelled http and
+	// https links are opened in the default browser instead. An empty origin
+	// removes the restriction.
+	RestrictToOrigin(origin string, openExternal bool)
+
+	// SendMouseInput injects a mouse event at x, y in client coordinates of
+	// the window. It moves the real cursor and brings the window to the
+	// foreground, so it is meant for automated tests of the application.
+	SendMouseInput(x, y int, button MouseButton, kind InputEventKind) error
+
+	// SendKeyboardInput injects a key event for the given virtual-key code
+	// into the focused webview. InputMove is not valid for keys.
+	SendKeyboardInput(virtualKey uint16, kind InputEventKind) error
+
+	// SetNotificationHandler routes notifications created by the page through
+	// the Web Notification API to fn, with permission always granted. fn can
+	// forward them to ShowNotification or to the application's own UI.
+	SetNotificationHandler(fn func(title, body, icon string)) error
+
+	// ShowNotification shows a Windows notification for the window, using a
+	// notification area icon that is removed again by Destroy.
+	ShowNotification(title, body string) error
+
+	// SetMuted mutes or unmutes all audio of the document. Requires a
+	// WebView2 runtime with ICoreWebView2_8.
+	SetMuted(muted bool) error
+
+	// PauseAllMedia pauses the playing audio and video elements of the top
+	// level document and mutes it. ResumeAllMedia undoes it, restarting only
+	// the elements that PauseAllMedia paused.
+	PauseAllMedia()
+	ResumeAllMedia()
+
+	// SetAudioPlaybackChangedHandler sets a function that is called whenever
+	// the document starts or stops playing audio.
+	SetAudioPlaybackChangedHandler(fn func(playing bool))
+
+	// SetMinimumFontSize makes text of all pages at least px CSS pixels tall,
+	// for users who need larger text than the page's design without zooming
+	// the whole layout. Zero removes the minimum again.
+	SetMinimumFontSize(px int)
+
+	// OnMessage registers a handler for messages the page sends with
+	// window.chrome.webview.postMessage. The handler receives the message as
+	// JSON; messages used by Bind are not passed on.
+	OnMessage(handler func(raw json.RawMessage))
+
+	// OnMessageTyped is like OnMessage but decodes each message into a new
+	// value of the type of prototype and passes that to handler. If prototype
+	// is a pointer, handler receives a pointer as well. A nil prototype is an
+	// error, since it has no type to decode into; use a typed nil pointer
+	// such as (*T)(nil) instead.
+	OnMessageTyped(prototype interface{}, handler func(interface{})) error
+
 	// Init injects JavaScript code at the initialization of the new page. Every
 	// time the webview will open a the new page - this initialization code will
 	// be executed. It is guaranteed that code is executed before window.onload.
 	Init(js string)
 
+	// InitFromFile reads the script at path and injects it like Init.
+	InitFromFile(path string) error
+
+	// InitFromFS reads the script name from fsys, e.g. an embed.FS, and
+	// injects it like Init.
+	InitFromFS(fsys fs.FS, name string) error
+
 	// Eval evaluates arbitrary JavaScript code. Evaluation happens asynchronously,
 	// also the result of the expression is ignored. Use RPC bindings if you want
 	// to receive notifications about the results of the evaluation.
 	Eval(js string)
 
+	// EvalAsync evaluates js like Eval and calls cb on the main thread with
+	// the JSON encoded value of the last expression. If the script throws or
+	// the runtime rejects the call, cb receives the error instead.
+	EvalAsync(js string, cb func(result string, err error))
+
+	// EvalBatch evaluates several scripts in order with a single dispatch to
+	// the main thread and a single call into the browser. Every script runs
+	// in its own function scope, and one that throws doesn't stop the others.
+	// A syntax error in any script keeps the whole batch from running and is
+	// reported for every script. If cb is not nil
+	// it is called on the main thread once all have run, with one entry per
+	// script, nil for the scripts that ran without error. EvalBatch may be
+	// called from any goroutine.
+	EvalBatch(scripts []string, cb func(errs []error))
+
+	// GetScrollPosition returns the scroll offset of the document in CSS
+	// pixels. It waits for the page to answer, so it must not be called from
+	// the main thread.
+	GetScrollPosition() (x, y int, err error)
+
+	// ScrollTo scrolls the document to the offset x, y in CSS pixels. To keep
+	// the reading position across a reload, save GetScrollPosition before
+	// reloading and call ScrollTo once the new document has loaded, e.g. from
+	// the handler set with SetDOMContentLoadedHandler.
+	ScrollTo(x, y int)
+
+	// DocumentHTML returns the serialized DOM of the main frame, i.e. the
+	// outerHTML of the document element as currently rendered. It waits for
+	// the page to answer, so it must not be called from the main thread.
+	DocumentHTML() (string, error)
+
+	// SetBrowserProcessPriority sets the priority class of the browser
+	// process and the runtime processes it started, e.g.
+	// windows.BELOW_NORMAL_PRIORITY_CLASS while the application is in the
+	// background. Processes started afterwards use the default priority.
+	SetBrowserProcessPriority(priority int) error
+
+	// SetSmoothScrollingEnabled turns animated scrolling on or off. The
+	// current webview stops animating anchor jumps and scripted scrolls right
+	// away; animated wheel and keyboard scrolling is a switch of the browser
+	// process, so that part only applies to webviews created afterwards.
+	SetSmoothScrollingEnabled(enabled bool)
+
+	// SetJSErrorHandler sets a function that is called with the details of
+	// uncaught exceptions and unhandled promise rejections in the page, e.g.
+	// to log front-end crashes. line is 0 and source empty when unknown. Like
+	// Init it affects the current and all later documents; nil removes the
+	// handler.
+	SetJSErrorHandler(fn func(message, source string, line int, stack string))
+
+	// SetConsoleMessageHandler sets a function that receives everything the
+	// page writes with console.debug, log, info, warn and error, e.g. to keep
+	// it in a log file. level is the name of the console method; objects are
+	// formatted as JSON. The output still appears in DevTools. nil removes the
+	// handler.
+	SetConsoleMessageHandler(fn func(level, message string))
+
+	// CallDevToolsProtocolMethod calls a method of the Chrome DevTools
+	// Protocol, e.g. "Network.emulateNetworkConditions", with the JSON encoded
+	// parameters and returns the JSON encoded result. An empty paramsJSON is
+	// sent as "{}". If the method fails, the error includes the protocol's
+	// JSON encoded error object. It waits for the browser to answer, so it
+	// must not be called from the main thread.
+	CallDevToolsProtocolMethod(method, paramsJSON string) (string, error)
+
+	// SubscribeDevToolsEvent calls cb on the main thread with the JSON encoded
+	// parameters of every Chrome DevTools Protocol event named event, e.g.
+	// "Runtime.consoleAPICalled". Most domains only send events once their
+	// enable method, e.g. "Runtime.enable", has been called.
+	SubscribeDevToolsEvent(event string, cb func(json string)) error
+
+	// EmulateDevice makes the page render as on a device with a viewport of
+	// width x height CSS pixels and the device pixel ratio dpr, e.g. 390,
+	// 844, 3 for a phone. mobile enables the mobile viewport meta tag and
+	// overlay scrollbars. A width or height of 0 keeps the actual size.
+	EmulateDevice(width, height int, dpr float64, mobile bool) error
+
+	// ClearDeviceEmulation removes the override set with EmulateDevice.
+	ClearDeviceEmulation() error
+
+	// SetNetworkConditions emulates the network connection of the webview,
+	// e.g. true, 0, 0, 0 for no connection or false, 400, 400, 400 for a slow
+	// 3G network. A rate of 0 kbit/s leaves that direction unthrottled, so
+	// false, 0, 0, 0 restores the normal connection.
+	SetNetworkConditions(offline bool, downloadKbps, uploadKbps, latencyMs int) error
+
 	// Bind binds a callback function so that it will appear under the given name
 	// as a global JavaScript function. Internally it uses webview_init().
 	// Callback receives a request string and a user-provided argument pointer.
@@ -83,8 +464,57 @@ type WebView interface {
 	//
 	// f must be a function
 	// f must return either value and error or just error
+	//
+	// If the value returned by f is an io.Reader it is not marshalled to
+	// JSON. The promise resolves to a fetch Response instead, which streams
+	// the reader's data so that large results never have to fit in a single
+	// message. The reader is closed afterwards if it is an io.Closer. A
+	// reader whose response the page doesn't fetch within 30 seconds, for
+	// example because it navigated away, is dropped and closed as well.
+	//
+	// A json.RawMessage, or any other json.Marshaler, is inserted as it is,
+	// so the promise resolves to the parsed value rather than a string.
 	Bind(name string, f interface{}) error
 
+	// BindEmitter binds f as the global JavaScript function name for long
+	// running work that reports progress. The JavaScript function takes a
+	// callback, which is called with every value f passes to emit, and
+	// returns a promise that resolves once f returns:
+	//
+	//	await scan(function(update) { progress.value = update.done; });
+	//
+	// f runs on its own goroutine and emit may be called from any goroutine
+	// until f returns. Values are encoded like the results of Bind.
+	BindEmitter(name string, f func(emit func(interface{}))) error
+
+	// SetBindingOriginFilter restricts the pages that may call functions
+	// registered with Bind. Before a bound function runs, fn is called with
+	// the origin of the document that called it, e.g. "https://example.com"
+	// or "file://", and the call is rejected unless fn returns true. A nil fn
+	// allows calls from every origin, which is the default.
+	SetBindingOriginFilter(fn func(origin string) bool)
+
+	// SetCookie adds or updates a cookie in the WebView2 cookie manager. Must be
+	// called from the UI thread.
+	SetCookie(c Cookie) error
+
+	// ImportCookies copies the cookies jar holds for u into the webview, so a
+	// session established with net/http can be reused by the page. Must be
+	// called from the UI thread.
+	ImportCookies(jar http.CookieJar, u *url.URL) error
+
+	// ExportCookies writes all cookies of the webview's profile to path as
+	// JSON, e.g. to back up a login or move it to another machine. The file
+	// contains session credentials, so its access list is replaced with one
+	// that only grants the current user access.
+	// It waits for the cookie manager, so it must not be called from the
+	// main thread.
+	ExportCookies(path string) error
+
+	// ImportCookiesFromFile restores the cookies written by ExportCookies.
+	// Cookies that have expired since are skipped.
+	ImportCookiesFromFile(path string) error
+
 	// MessageBox windows消息弹窗
 	MessageBox(caption, text string)
 
@@ -94,6 +524,94 @@ type WebView interface {
 	// MostTop 移动到最上层（参数为true时，强制到最上层，否则显示在其他最上层窗口后）
 	MostTop(isTop bool)
 
+	// Monitors returns the displays attached to the desktop in the order
+	// Windows enumerates them.
+	Monitors() []MonitorInfo
+
+	// MoveToMonitor moves the window to the work area of the monitor at
+	// index in Monitors, keeping its size. With center the window is centred
+	// on that monitor, otherwise it is placed at its top-left corner.
+	MoveToMonitor(index int, center bool) error
+
+	// SetAlwaysOnTop keeps the window above all non-topmost windows until it
+	// is called again with false. Unlike MostTop it neither moves nor
+	// activates the window.
+	SetAlwaysOnTop(onTop bool)
+
+	// IsAlwaysOnTop reports whether the window is currently topmost.
+	IsAlwaysOnTop() bool
+
+	// GetWindowPlacement returns the show state of the window together with
+	// its restored position, which unlike the current bounds survives
+	// maximizing and minimizing. Save it on exit and pass it to
+	// SetWindowPlacement on the next start to restore the layout.
+	GetWindowPlacement() WindowPlacement
+
+	// SetWindowPlacement moves the window to p.Normal and applies p.State.
+	SetWindowPlacement(p WindowPlacement)
+
+	// BringToFrontTemporarily restores the window if it is minimized and
+	// raises it above all other windows, including topmost ones. Shortly
+	// after it has been shown the topmost state is dropped again, so other
+	// windows can cover it as usual. A window made topmost with
+	// SetAlwaysOnTop stays topmost. It is safe to call from any goroutine.
+	BringToFrontTemporarily()
+
+	// FlashWindow flashes the taskbar button count times to get the user's
+	// attention without stealing focus. With count <= 0 it keeps flashing until
+	// the window comes to the foreground.
+	FlashWindow(count int)
+
+	// SetOpacity sets the opacity of the whole window, from 0 (invisible) to 255
+	// (opaque), by making it a layered window. Layered windows are composed by
+	// the DWM, so this needs hardware acceleration to stay smooth, and a
+	// translucent window still receives all mouse input.
+	SetOpacity(alpha uint8)
+
+	// SetClickThrough lets mouse input pass through the window to whatever is
+	// beneath it, for HUD or stream overlay windows that only display
+	// information. Keyboard focus is unaffected.
+	SetClickThrough(enabled bool)
+
+	// SetTransparentColorKey makes every pixel of the window painted in
+	// exactly the color r, g, b fully transparent and lets mouse input on it
+	// pass through, e.g. for a non-rectangular splash screen whose page
+	// paints the area outside its shape in that color. Antialiased edges
+	// keep their blended color, so pick a color that doesn't occur in the
+	// content. It combines with SetOpacity and SetClickThrough.
+	SetTransparentColorKey(r, g, b uint8)
+
+	// ClearTransparentColorKey undoes SetTransparentColorKey.
+	ClearTransparentColorKey()
+
+	// SetRoundedCorners rounds the corners of the window, e.g. for frameless
+	// windows, or makes them square with a radius of 0. Windows 11 only
+	// offers a small (4px) and a regular (8px) radius and picks the one that
+	// fits radius. Older versions clip the window to a rounded rectangle
+	// with the exact radius instead, which is kept up to date when the
+	// window is resized but has jagged edges.
+	SetRoundedCorners(radius int) error
+
+	// SetWindowRegion clips the window to region, in coordinates relative
+	// to the window's top-left corner, for arbitrarily shaped windows. The
+	// system takes ownership of region, so it must not be used or deleted
+	// afterwards. A region of 0 removes the clipping. It replaces the clipping
+	// done by SetRoundedCorners.
+	SetWindowRegion(region win.HRGN)
+
+	// SetDisplayAffinity excludes the window from screenshots, screen
+	// recordings and screen sharing, for windows showing sensitive data. The
+	// window stays visible on the local screen. Windows 10 before version
+	// 2004 show a black rectangle in its place instead.
+	SetDisplayAffinity(exclude bool) error
+
+	// SetBackgroundColor sets the color shown behind the page content. The
+	// runtime only supports a fully opaque (255) or fully transparent (0) alpha;
+	// with 0 the host window shows through wherever the page itself has a
+	// transparent background, which combined with SetOpacity allows overlay
+	// style windows.
+	SetBackgroundColor(r, g, b, a uint8) error
+
 	// RestoreWindow 还原窗口（一般为最小化后执行此方法还原窗口）
 	RestoreWindow()
 
@@ -0,0 +1,76 @@
+//go:build windows
+// +build windows
+
+package webview2
+
+import (
+	"encoding/json"
+	"log"
+)
+
+// consoleMethod is the RPC method name under which the page forwards console
+// output. Like jsErrorMethod it is handled before bindings.
+const consoleMethod = "__webview2_console"
+
+// consoleScript wraps the console methods so that every call is also posted
+// to the host. The original methods still run, so DevTools keeps working.
+const consoleScript = `(function() {
+	if (window.__webview2Console) return;
+	window.__webview2Console = true;
+	function format(value) {
+		if (typeof value === "string") return value;
+		if (value instanceof Error) return value.stack || String(value);
+		try {
+			var s = JSON.stringify(value);
+			return s === undefined ? String(value) : s;
+		} catch (e) {
+			return String(value);
+		}
+	}
+	["debug", "log", "info", "warn", "error"].forEach(function(level) {
+		var original = console[level];
+		console[level] = function() {
+			try {
+				window.external.invoke(JSON.stringify({
+					id: 0,
+					method: "` + consoleMethod + `",
+					params: [level, Array.prototype.map.call(arguments, format).join(" ")]
+				}));
+			} catch (e) {}
+			return original.apply(console, arguments);
+		};
+	});
+})();`
+
+func (w *webview) SetConsoleMessageHandler(fn func(level, message string)) {
+	w.m.Lock()
+	install := fn != nil && !w.consoleHooked
+	if install {
+		w.consoleHooked = true
+	}
+	w.consoleHandler = fn
+	w.m.Unlock()
+	if install {
+		w.Init(consoleScript)
+		w.Eval(consoleScript)
+	}
+}
+
+func (w *webview) consoleMessage(params []json.RawMessage) {
+	w.m.Lock()
+	fn := w.consoleHandler
+	w.m.Unlock()
+	if fn == nil || len(params) != 2 {
+		return
+	}
+	var level, message string
+	if err := json.Unmarshal(params[0], &level); err != nil {
+		log.Printf("invalid console message: %v", err)
+		return
+	}
+	if err := json.Unmarshal(params[1], &message); err != nil {
+		log.Printf("invalid console message: %v", err)
+		return
+	}
+	fn(level, message)
+}
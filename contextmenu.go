@@ -0,0 +1,64 @@
+//go:build windows
+// +build windows
+
+package webview2
+
+import "github.com/mzky/go-webview2/pkg/edge"
+
+func (w *webview) SetContextMenuItemsToRemove(names []string) error {
+	webview11 := w.chromium().GetICoreWebView2_11()
+	if webview11 == nil {
+		return &edge.FeatureUnavailableError{Interface: "ICoreWebView2_11"}
+	}
+	webview11.Release()
+	if len(names) == 0 {
+		w.chromium().ContextMenuRequestedCallback = nil
+		return nil
+	}
+	remove := make(map[string]bool, len(names))
+	for _, name := range names {
+		remove[name] = true
+	}
+	w.chromium().ContextMenuRequestedCallback = func(args *edge.ICoreWebView2ContextMenuRequestedEventArgs) {
+		items, err := args.GetMenuItems()
+		if err != nil {
+			return
+		}
+		defer items.Release()
+		removeContextMenuItems(items, remove)
+	}
+	return nil
+}
+
+func (w *webview) SetContextMenusEnabled(enabled bool) error {
+	var err error
+	w.sync(func() {
+		var settings *edge.ICoreWebViewSettings
+		if settings, err = w.chromium().GetSettings(); err == nil {
+			err = settings.PutAreDefaultContextMenusEnabled(enabled)
+		}
+	})
+	return err
+}
+
+// removeContextMenuItems removes the items named in remove from items and
+// from all of its submenus.
+func removeContextMenuItems(items *edge.ICoreWebView2ContextMenuItemCollection, remove map[string]bool) {
+	count, err := items.GetCount()
+	if err != nil {
+		return
+	}
+	for i := count; i > 0; i-- {
+		item, err := items.GetValueAtIndex(i - 1)
+		if err != nil {
+			continue
+		}
+		if name, err := item.GetName(); err == nil && remove[name] {
+			_ = items.RemoveValueAtIndex(i - 1)
+		} else if children, err := item.GetChildren(); err == nil && children != nil {
+			removeContextMenuItems(children, remove)
+			children.Release()
+		}
+		item.Release()
+	}
+}
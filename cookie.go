@@ -0,0 +1,341 @@
+//go:build windows
+// +build windows
+
+package webview2
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+
+	"github.com/mzky/go-webview2/internal/w32"
+	"github.com/mzky/go-webview2/pkg/edge"
+	"golang.org/x/sys/windows"
+)
+
+// Cookie describes a cookie stored by the WebView2 cookie manager.
+type Cookie struct {
+	Name   string
+	Value  string
+	Domain string
+	Path   string
+
+	// Expires is the expiration date of the cookie. The zero value creates a
+	// session cookie.
+	Expires time.Time
+
+	HttpOnly bool
+	Secure   bool
+
+	// SameSite defaults to the runtime's choice (Lax) when left unset.
+	SameSite http.SameSite
+}
+
+func (w *webview) SetCookie(c Cookie) error {
+	manager, err := w.chromium().GetCookieManager()
+	if err != nil {
+		return err
+	}
+	defer manager.Release()
+
+	cookie, err := manager.CreateCookie(c.Name, c.Value, c.Domain, c.Path)
+	if err != nil {
+		return err
+	}
+	defer cookie.Release()
+
+	if !c.Expires.IsZero() {
+		if err := cookie.PutExpires(float64(c.Expires.UnixNano()) / float64(time.Second)); err != nil {
+			return err
+		}
+	}
+	if err := cookie.PutIsHttpOnly(c.HttpOnly); err != nil {
+		return err
+	}
+	if err := cookie.PutIsSecure(c.Secure); err != nil {
+		return err
+	}
+	switch c.SameSite {
+	case http.SameSiteLaxMode:
+		err = cookie.PutSameSite(edge.COREWEBVIEW2_COOKIE_SAME_SITE_KIND_LAX)
+	case http.SameSiteStrictMode:
+		err = cookie.PutSameSite(edge.COREWEBVIEW2_COOKIE_SAME_SITE_KIND_STRICT)
+	case http.SameSiteNoneMode:
+		err = cookie.PutSameSite(edge.COREWEBVIEW2_COOKIE_SAME_SITE_KIND_NONE)
+	}
+	if err != nil {
+		return err
+	}
+	return manager.AddOrUpdateCookie(cookie)
+}
+
+func (w *webview) ImportCookies(jar http.CookieJar, u *url.URL) error {
+	if jar == nil || u == nil {
+		return errors.New("cookie jar and url must not be nil")
+	}
+	// A jar only hands out name and value, so the cookies are scoped to the
+	// host of u and the root path.
+	for _, c := range jar.Cookies(u) {
+		err := w.SetCookie(Cookie{
+			Name:   c.Name,
+			Value:  c.Value,
+			Domain: u.Hostname(),
+			Path:   "/",
+			Secure: u.Scheme == "https",
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// cookieTimerID identifies the timer that polls the cookies watched with
+// SetCookieChangedHandler.
+const cookieTimerID = 3
+
+// defaultCookiePollInterval is used when WebViewOptions.CookiePollInterval
+// is not set.
+const defaultCookiePollInterval = time.Second
+
+// cookieWatcher polls the cookies of one uri, as the runtime has no cookie
+// change event, and reports the differences between two polls.
+type cookieWatcher struct {
+	handler func(c Cookie, deleted bool)
+	pending bool
+	cookies map[string]Cookie // nil until the first poll completed
+}
+
+func (w *webview) SetCookieChangedHandler(uri string, fn func(c Cookie, deleted bool)) {
+	// The timer only works from the window's thread, and pollCookies
+	// iterates w.cookieWatchers there.
+	w.sync(func() {
+		if fn == nil {
+			delete(w.cookieWatchers, uri)
+			if len(w.cookieWatchers) == 0 {
+				_, _, _ = w32.User32KillTimer.Call(w.hWnd, cookieTimerID)
+			}
+			return
+		}
+		if w.cookieWatchers == nil {
+			w.cookieWatchers = map[string]*cookieWatcher{}
+		}
+		if len(w.cookieWatchers) == 0 {
+			_, _, _ = w32.User32SetTimer.Call(w.hWnd, cookieTimerID, uintptr(w.cookiePoll/time.Millisecond), 0)
+		}
+		w.cookieWatchers[uri] = &cookieWatcher{handler: fn}
+	})
+}
+
+func (w *webview) pollCookies() {
+	for uri, watcher := range w.cookieWatchers {
+		if watcher.pending {
+			continue
+		}
+		watcher := watcher
+		watcher.pending = true
+		err := w.chromium().GetCookies(uri, func(list *edge.ICoreWebView2CookieList, err error) {
+			watcher.pending = false
+			if err == nil {
+				watcher.update(list)
+			}
+		})
+		if err != nil {
+			watcher.pending = false
+		}
+	}
+}
+
+func (c *cookieWatcher) update(list *edge.ICoreWebView2CookieList) {
+	count, err := list.GetCount()
+	if err != nil {
+		return
+	}
+	cookies := make(map[string]Cookie, count)
+	for i := uint32(0); i < count; i++ {
+		cookie, err := list.GetValueAtIndex(i)
+		if err != nil {
+			return
+		}
+		converted, err := cookieFromEdge(cookie)
+		cookie.Release()
+		if err != nil {
+			return
+		}
+		cookies[converted.Domain+converted.Path+";"+converted.Name] = converted
+	}
+
+	previous := c.cookies
+	c.cookies = cookies
+	if previous == nil {
+		return
+	}
+	for key, cookie := range cookies {
+		if old, ok := previous[key]; !ok || old != cookie {
+			c.handler(cookie, false)
+		}
+	}
+	for key, cookie := range previous {
+		if _, ok := cookies[key]; !ok {
+			c.handler(cookie, true)
+		}
+	}
+}
+
+func cookieFromEdge(cookie *edge.ICoreWebView2Cookie) (Cookie, error) {
+	var c Cookie
+	var err error
+	if c.Name, err = cookie.GetName(); err != nil {
+		return c, err
+	}
+	if c.Value, err = cookie.GetValue(); err != nil {
+		return c, err
+	}
+	if c.Domain, err = cookie.GetDomain(); err != nil {
+		return c, err
+	}
+	if c.Path, err = cookie.GetPath(); err != nil {
+		return c, err
+	}
+	expires, err := cookie.GetExpires()
+	if err != nil {
+		return c, err
+	}
+	if expires >= 0 {
+		c.Expires = time.Unix(0, int64(expires*float64(time.Second)))
+	}
+	if c.HttpOnly, err = cookie.GetIsHttpOnly(); err != nil {
+		return c, err
+	}
+	if c.Secure, err = cookie.GetIsSecure(); err != nil {
+		return c, err
+	}
+	sameSite, err := cookie.GetSameSite()
+	if err != nil {
+		return c, err
+	}
+	switch sameSite {
+	case edge.COREWEBVIEW2_COOKIE_SAME_SITE_KIND_LAX:
+		c.SameSite = http.SameSiteLaxMode
+	case edge.COREWEBVIEW2_COOKIE_SAME_SITE_KIND_STRICT:
+		c.SameSite = http.SameSiteStrictMode
+	case edge.COREWEBVIEW2_COOKIE_SAME_SITE_KIND_NONE:
+		c.SameSite = http.SameSiteNoneMode
+	}
+	return c, nil
+}
+
+func (w *webview) ExportCookies(path string) error {
+	if w.onMainThread() {
+		return errors.New("webview2: ExportCookies would block the main thread")
+	}
+	type result struct {
+		cookies []Cookie
+		err     error
+	}
+	results := make(chan result, 1)
+	w.Dispatch(func() {
+		// An empty uri returns the cookies of all sites.
+		err := w.chromium().GetCookies("", func(list *edge.ICoreWebView2CookieList, err error) {
+			if err != nil {
+				results <- result{nil, err}
+				return
+			}
+			count, err := list.GetCount()
+			if err != nil {
+				results <- result{nil, err}
+				return
+			}
+			cookies := make([]Cookie, 0, count)
+			for i := uint32(0); i < count; i++ {
+				cookie, err := list.GetValueAtIndex(i)
+				if err != nil {
+					results <- result{nil, err}
+					return
+				}
+				converted, err := cookieFromEdge(cookie)
+				cookie.Release()
+				if err != nil {
+					results <- result{nil, err}
+					return
+				}
+				cookies = append(cookies, converted)
+			}
+			results <- result{cookies, nil}
+		})
+		if err != nil {
+			results <- result{nil, err}
+		}
+	})
+	r := <-results
+	if r.err != nil {
+		return r.err
+	}
+	b, err := json.MarshalIndent(r.cookies, "", "\t")
+	if err != nil {
+		return err
+	}
+	// The file holds session credentials. The permission bits only set the
+	// read-only attribute on Windows, so access is limited by the DACL,
+	// which is applied before anything is written.
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	if err := restrictToCurrentUser(path); err != nil {
+		f.Close()
+		return fmt.Errorf("webview2: restricting access to %s: %w", path, err)
+	}
+	if _, err := f.Write(b); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// restrictToCurrentUser replaces the DACL of the file at path, including the
+// entries inherited from its directory, with one that grants access to the
+// current user only.
+func restrictToCurrentUser(path string) error {
+	user, err := windows.GetCurrentProcessToken().GetTokenUser()
+	if err != nil {
+		return err
+	}
+	sd, err := windows.SecurityDescriptorFromString("D:P(A;;FA;;;" + user.User.Sid.String() + ")")
+	if err != nil {
+		return err
+	}
+	dacl, _, err := sd.DACL()
+	if err != nil {
+		return err
+	}
+	return windows.SetNamedSecurityInfo(path, windows.SE_FILE_OBJECT,
+		windows.DACL_SECURITY_INFORMATION|windows.PROTECTED_DACL_SECURITY_INFORMATION, nil, nil, dacl, nil)
+}
+
+func (w *webview) ImportCookiesFromFile(path string) error {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	var cookies []Cookie
+	if err := json.Unmarshal(b, &cookies); err != nil {
+		return fmt.Errorf("webview2: reading cookies from %s: %w", path, err)
+	}
+	now := time.Now()
+	w.sync(func() {
+		for _, c := range cookies {
+			if !c.Expires.IsZero() && c.Expires.Before(now) {
+				continue
+			}
+			if err = w.SetCookie(c); err != nil {
+				return
+			}
+		}
+	})
+	return err
+}
@@ -0,0 +1,38 @@
+//go:build windows
+// +build windows
+
+package webview2
+
+import (
+	"log"
+	"os"
+	"time"
+)
+
+// dataPathExitTimeout is how long removeDataPath waits for the browser
+// process to exit. Another webview using the same user data folder keeps the
+// process, and its locks on the folder, alive for longer than that.
+const dataPathExitTimeout = 5 * time.Second
+
+// removeDataPath closes the browser and deletes its user data folder once the
+// browser process has exited, for WebViewOptions.CleanupDataPathOnClose. done
+// is called on the main thread afterwards; the message loop has to keep
+// running until then.
+func (w *webview) removeDataPath(done func()) {
+	folder := w.chromium().UserDataFolder()
+	finished := false
+	finish := func() {
+		if finished {
+			return
+		}
+		finished = true
+		if err := os.RemoveAll(folder); err != nil {
+			log.Printf("webview2: removing data path %s: %v", folder, err)
+		}
+		done()
+	}
+	w.chromium().Close(finish)
+	time.AfterFunc(dataPathExitTimeout, func() {
+		w.Dispatch(finish)
+	})
+}
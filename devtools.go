@@ -0,0 +1,34 @@
+//go:build windows
+// +build windows
+
+package webview2
+
+import "errors"
+
+func (w *webview) CallDevToolsProtocolMethod(method, paramsJSON string) (string, error) {
+	if w.onMainThread() {
+		return "", errors.New("webview2: CallDevToolsProtocolMethod would block the main thread")
+	}
+	if paramsJSON == "" {
+		paramsJSON = "{}"
+	}
+	results := make(chan scriptResult, 1)
+	w.Dispatch(func() {
+		err := w.chromium().CallDevToolsProtocolMethod(method, paramsJSON, func(result string, err error) {
+			results <- scriptResult{result, err}
+		})
+		if err != nil {
+			results <- scriptResult{"", err}
+		}
+	})
+	r := <-results
+	return r.result, r.err
+}
+
+func (w *webview) SubscribeDevToolsEvent(event string, cb func(json string)) error {
+	var err error
+	w.sync(func() {
+		err = w.chromium().SubscribeDevToolsEvent(event, cb)
+	})
+	return err
+}
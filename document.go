@@ -0,0 +1,34 @@
+//go:build windows
+// +build windows
+
+package webview2
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+func (w *webview) DocumentHTML() (string, error) {
+	if w.onMainThread() {
+		return "", errors.New("webview2: DocumentHTML would block the main thread")
+	}
+	results := make(chan scriptResult, 1)
+	w.Dispatch(func() {
+		err := w.chromium().ExecuteScript("document.documentElement ? document.documentElement.outerHTML : ''", func(result string, err error) {
+			results <- scriptResult{result, err}
+		})
+		if err != nil {
+			results <- scriptResult{"", err}
+		}
+	})
+	r := <-results
+	if r.err != nil {
+		return "", r.err
+	}
+	// ExecuteScript returns the markup as a JSON encoded string.
+	var html string
+	if err := json.Unmarshal([]byte(r.result), &html); err != nil {
+		return "", err
+	}
+	return html, nil
+}
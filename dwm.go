@@ -0,0 +1,77 @@
+//go:build windows
+// +build windows
+
+package webview2
+
+import (
+	"errors"
+
+	"github.com/mzky/go-webview2/internal/w32"
+	"golang.org/x/sys/windows"
+)
+
+// Backdrop is the material drawn behind the window by SetSystemBackdrop.
+type Backdrop int
+
+const (
+	BackdropAuto    Backdrop = 0 // let the system decide
+	BackdropNone    Backdrop = 1
+	BackdropMica    Backdrop = 2
+	BackdropAcrylic Backdrop = 3
+	BackdropTabbed  Backdrop = 4 // Mica Alt
+)
+
+// Windows builds that introduced the DWM attributes used here.
+const (
+	buildWindows10_1809 = 17763
+	buildWindows10_20H1 = 18985
+	buildWindows11      = 22000
+	buildWindows11H222  = 22621
+)
+
+var errUnsupportedWindows = errors.New("webview2: not supported by this version of Windows")
+
+func windowsBuild() uint32 {
+	return windows.RtlGetVersion().BuildNumber
+}
+
+func (w *webview) SetSystemBackdrop(kind Backdrop) error {
+	if windowsBuild() < buildWindows11H222 {
+		return errUnsupportedWindows
+	}
+	return w32.DwmSetWindowAttribute(w.hWnd, w32.DWMWASystemBackdropType, uint32(kind))
+}
+
+func (w *webview) SetTitleBarColor(r, g, b uint8) error {
+	if windowsBuild() < buildWindows11 {
+		return errUnsupportedWindows
+	}
+	// COLORREF is 0x00BBGGRR.
+	color := uint32(r) | uint32(g)<<8 | uint32(b)<<16
+	return w32.DwmSetWindowAttribute(w.hWnd, w32.DWMWACaptionColor, color)
+}
+
+func (w *webview) SetDarkTitleBar(dark bool) error {
+	build := windowsBuild()
+	if build < buildWindows10_1809 {
+		return errUnsupportedWindows
+	}
+	// The attribute was documented with a new number in 20H1; earlier
+	// builds only know the old one.
+	attribute := uint32(w32.DWMWAUseImmersiveDarkMode)
+	if build < buildWindows10_20H1 {
+		attribute = w32.DWMWAUseImmersiveDarkModeBefore20H1
+	}
+	var value uint32
+	if dark {
+		value = 1
+	}
+	if err := w32.DwmSetWindowAttribute(w.hWnd, attribute, value); err != nil {
+		return err
+	}
+	// The frame is only repainted on the next activation otherwise.
+	_, _, _ = w32.User32SetWindowPos.Call(
+		w.hWnd, 0, 0, 0, 0, 0,
+		w32.SWPNoZOrder|w32.SWPNoActivate|w32.SWPNoMove|w32.SWPNoSize|w32.SWPFrameChanged)
+	return nil
+}
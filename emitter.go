@@ -0,0 +1,102 @@
+//go:build windows
+// +build windows
+
+package webview2
+
+import (
+	"errors"
+	"log"
+	"strconv"
+)
+
+// emitterScript defines a function bound with BindEmitter. Updates and the
+// end of the call arrive as web messages, so they keep their order.
+const emitterScript = `
+	var RPC = window._rpc = (window._rpc || {nextSeq: 1});
+	if (!RPC.emitterListener) {
+	  RPC.emitterListener = true;
+	  window.chrome.webview.addEventListener("message", function(e) {
+		var d = e.data;
+		if (!d || typeof d !== "object") return;
+		if ("rpcEmit" in d) {
+		  var c = RPC[d.rpcEmit];
+		  if (c && c.emit) c.emit(d.data);
+		} else if ("rpcDone" in d) {
+		  var c = RPC[d.rpcDone];
+		  if (c) {
+			RPC[d.rpcDone] = undefined;
+			if (d.error !== undefined) c.reject(d.error); else c.resolve();
+		  }
+		}
+	  });
+	}
+	window[name] = function(onEmit) {
+	  var seq = RPC.nextSeq++;
+	  var promise = new Promise(function(resolve, reject) {
+		RPC[seq] = {
+		  resolve: resolve,
+		  reject: reject,
+		  emit: onEmit,
+		};
+	  });
+	  window.external.invoke(JSON.stringify({
+		id: seq,
+		method: name,
+		params: [],
+	  }));
+	  return promise;
+	}
+`
+
+func (w *webview) BindEmitter(name string, f func(emit func(interface{}))) error {
+	if f == nil {
+		return errors.New("webview2: BindEmitter needs a function")
+	}
+	w.m.Lock()
+	if w.emitters == nil {
+		w.emitters = map[string]func(emit func(interface{})){}
+	}
+	w.emitters[name] = f
+	w.m.Unlock()
+
+	w.Init("(function() { var name = " + jsString(name) + ";" + emitterScript + "})()")
+	return nil
+}
+
+// callEmitter runs the emitter bound as method for the call id on its own
+// goroutine. It reports false if method is not an emitter.
+func (w *webview) callEmitter(id int, method string) bool {
+	w.m.Lock()
+	f, ok := w.emitters[method]
+	w.m.Unlock()
+	if !ok {
+		return false
+	}
+	seq := strconv.Itoa(id)
+	post := func(message string) {
+		w.Dispatch(func() {
+			if err := w.chromium().PostWebMessageAsJSON(message); err != nil {
+				log.Printf("webview2: posting to %s: %v", method, err)
+			}
+		})
+	}
+	go func() {
+		done := `{"rpcDone":` + seq + `}`
+		defer func() {
+			if r := recover(); r != nil {
+				done = `{"rpcDone":` + seq + `,"error":` + jsString("panic in "+method) + `}`
+				log.Printf("webview2: %s panicked: %v", method, r)
+			}
+			post(done)
+		}()
+		f(func(v interface{}) {
+			b, err := w.marshalResult(v)
+			if err != nil {
+				log.Printf("webview2: %s emitted a value that can't be encoded: %v", method, err)
+				return
+			}
+			post(`{"rpcEmit":` + seq + `,"data":` + string(b) + `}`)
+		})
+	}()
+	return true
+}
@@ -0,0 +1,67 @@
+//go:build windows
+// +build windows
+
+package webview2
+
+import (
+	"encoding/json"
+	"log"
+)
+
+func (w *webview) EmulateDevice(width, height int, dpr float64, mobile bool) error {
+	params, err := json.Marshal(map[string]interface{}{
+		"width":             width,
+		"height":            height,
+		"deviceScaleFactor": dpr,
+		"mobile":            mobile,
+	})
+	if err != nil {
+		return err
+	}
+	return w.postDevToolsMethod("Emulation.setDeviceMetricsOverride", string(params))
+}
+
+func (w *webview) ClearDeviceEmulation() error {
+	return w.postDevToolsMethod("Emulation.clearDeviceMetricsOverride", "{}")
+}
+
+func (w *webview) SetNetworkConditions(offline bool, downloadKbps, uploadKbps, latencyMs int) error {
+	params, err := json.Marshal(map[string]interface{}{
+		"offline":            offline,
+		"latency":            latencyMs,
+		"downloadThroughput": throughput(downloadKbps),
+		"uploadThroughput":   throughput(uploadKbps),
+	})
+	if err != nil {
+		return err
+	}
+	// The conditions only apply while the network domain is enabled.
+	if err := w.postDevToolsMethod("Network.enable", "{}"); err != nil {
+		return err
+	}
+	return w.postDevToolsMethod("Network.emulateNetworkConditions", string(params))
+}
+
+// throughput converts kbit/s to the bytes per second of the DevTools
+// protocol, where -1 disables throttling.
+func throughput(kbps int) int {
+	if kbps <= 0 {
+		return -1
+	}
+	return kbps * 1000 / 8
+}
+
+// postDevToolsMethod calls a DevTools protocol method without waiting for
+// its result, so that it can be used from the main thread. Errors reported
+// by the browser later on are logged.
+func (w *webview) postDevToolsMethod(method, params string) error {
+	var err error
+	w.sync(func() {
+		err = w.chromium().CallDevToolsProtocolMethod(method, params, func(_ string, err error) {
+			if err != nil {
+				log.Printf("webview2: %s failed: %v", method, err)
+			}
+		})
+	})
+	return err
+}
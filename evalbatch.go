@@ -0,0 +1,75 @@
+//go:build windows
+// +build windows
+
+package webview2
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+)
+
+func (w *webview) EvalBatch(scripts []string, cb func(errs []error)) {
+	if len(scripts) == 0 {
+		if cb != nil {
+			w.Dispatch(func() { cb(nil) })
+		}
+		return
+	}
+	// All scripts run in one ExecuteScript call, as the elements of an
+	// array literal. Each one is inside a function that isolates its scope
+	// and evaluates to true, or to the message of an exception. The text is
+	// inserted directly rather than passed to new Function, which a
+	// Content-Security-Policy without 'unsafe-eval' forbids. A syntax error
+	// in any script keeps the whole batch from running, so the result is
+	// null.
+	var batch strings.Builder
+	batch.WriteString("[")
+	for i, script := range scripts {
+		if i > 0 {
+			batch.WriteString(",")
+		}
+		batch.WriteString("(function() { try {\n" + script + "\n} catch (e) { return String(e); } return true; })()")
+	}
+	batch.WriteString("]")
+	w.Dispatch(func() {
+		fail := func(err error) {
+			if cb == nil {
+				return
+			}
+			errs := make([]error, len(scripts))
+			for i := range errs {
+				errs[i] = err
+			}
+			cb(errs)
+		}
+		err := w.chromium().ExecuteScript(batch.String(), func(result string, err error) {
+			if err != nil {
+				fail(err)
+				return
+			}
+			var outcomes []interface{}
+			if err := json.Unmarshal([]byte(result), &outcomes); err != nil {
+				fail(err)
+				return
+			}
+			if len(outcomes) != len(scripts) {
+				fail(errors.New("script error: the batch did not run"))
+				return
+			}
+			if cb == nil {
+				return
+			}
+			errs := make([]error, len(scripts))
+			for i, outcome := range outcomes {
+				if message, ok := outcome.(string); ok {
+					errs[i] = errors.New("script error: " + message)
+				}
+			}
+			cb(errs)
+		})
+		if err != nil {
+			fail(err)
+		}
+	})
+}
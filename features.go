@@ -0,0 +1,74 @@
+//go:build windows
+// +build windows
+
+package webview2
+
+import "github.com/mzky/go-webview2/webviewloader"
+
+// FeatureSet reports which optional capabilities the loaded WebView2 runtime
+// provides. Methods backed by a missing capability return an error.
+type FeatureSet struct {
+	Cookies             bool // ICoreWebView2_2: SetCookie, SetCookieChangedHandler
+	WebResourceResponse bool // ICoreWebView2_2: SetWebResourceResponseReceivedHandler
+	DOMContentLoaded    bool // ICoreWebView2_2: SetDOMContentLoadedHandler
+	Frames              bool // ICoreWebView2_4: FrameNames, ExecuteScriptInFrame
+	DownloadStarting    bool // ICoreWebView2_4
+	PrintToPdf          bool // ICoreWebView2_7
+	Mute                bool // ICoreWebView2_8: SetMuted, SetAudioPlaybackChangedHandler
+	ContextMenu         bool // ICoreWebView2_11: SetContextMenuItemsToRemove
+	StatusBarText       bool // ICoreWebView2_12: SetStatusBarTextChangedHandler
+	Profile             bool // ICoreWebView2_13: Profile, SetDefaultDownloadFolder
+	TrackingPrevention  bool // ICoreWebView2Profile3: SetTrackingPreventionLevel
+	Favicon             bool // ICoreWebView2_15: SetFaviconChangedHandler
+	Print               bool // ICoreWebView2_16: Print, PrintWithSettings
+	CustomScheme        bool // runtime 101.0.1210.39: RegisterCustomScheme
+}
+
+// minCustomSchemeVersion is the first runtime that honours the custom scheme
+// registrations of the environment options.
+const minCustomSchemeVersion = "101.0.1210.39"
+
+func (w *webview) SupportedFeatures() FeatureSet {
+	w.sync(func() {
+		if w.features == nil {
+			features := w.probeFeatures()
+			w.features = &features
+		}
+	})
+	return *w.features
+}
+
+// probeFeatures asks the webview for each interface instead of comparing
+// version numbers, so that it is also right for fixed version runtimes.
+func (w *webview) probeFeatures() FeatureSet {
+	chromium := w.chromium()
+	f := FeatureSet{
+		Cookies:       chromium.SupportsInterface("{9E8F0CF8-E670-4B5E-B2BC-73E061E3184C}"),
+		Frames:        chromium.SupportsInterface("{20d02d59-6df2-42dc-bd06-f98a694b1302}"),
+		PrintToPdf:    chromium.SupportsInterface("{79c24d83-09a3-45ae-9418-487f32a58740}"),
+		Mute:          chromium.SupportsInterface("{E9632730-6E1E-43AB-B7B8-7B2C9E62E094}"),
+		ContextMenu:   chromium.SupportsInterface("{0be78e56-c193-4051-b943-23b460c08bdb}"),
+		StatusBarText: chromium.SupportsInterface("{35D69927-BCFA-4566-9349-6B3E0D154CAC}"),
+		Profile:       chromium.SupportsInterface("{F75F09A8-667E-4983-88D6-C8773F315E84}"),
+		Favicon:       chromium.SupportsInterface("{517B2D1D-7DAE-4A66-A4F4-10352FFB9518}"),
+		Print:         chromium.SupportsInterface("{0EB34DC9-9F91-41E1-8639-95CD5943906B}"),
+	}
+	f.WebResourceResponse = f.Cookies
+	f.DOMContentLoaded = f.Cookies
+	f.DownloadStarting = f.Frames
+
+	if profile, err := chromium.GetProfile(); err == nil {
+		if profile3 := profile.GetICoreWebView2Profile3(); profile3 != nil {
+			f.TrackingPrevention = true
+			profile3.Release()
+		}
+		profile.Release()
+	}
+
+	if version, err := webviewloader.GetInstalledVersion(); err == nil && version != "" {
+		if cmp, err := webviewloader.CompareBrowserVersions(version, minCustomSchemeVersion); err == nil {
+			f.CustomScheme = cmp >= 0
+		}
+	}
+	return f
+}
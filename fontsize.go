@@ -0,0 +1,65 @@
+//go:build windows
+// +build windows
+
+package webview2
+
+import "strconv"
+
+// minFontSizeScript enforces window.__webview2MinFontSize on every element
+// whose computed font size is smaller. CSS has no min-font-size property and
+// WebView2 doesn't expose Chromium's setting, so it is done from script and
+// kept up to date as the DOM changes. The original inline size is restored
+// when the minimum is lowered again.
+const minFontSizeScript = `(function() {
+	if (window.__webview2ApplyMinFontSize) {
+		window.__webview2ApplyMinFontSize();
+		return;
+	}
+	var scheduled = false;
+	function apply() {
+		scheduled = false;
+		if (!document.body) return;
+		var min = window.__webview2MinFontSize || 0;
+		var elements = document.body.getElementsByTagName("*");
+		for (var i = 0; i < elements.length; i++) {
+			var el = elements[i];
+			if (el.dataset.webview2FontSize !== undefined) {
+				el.style.fontSize = el.dataset.webview2FontSize;
+				delete el.dataset.webview2FontSize;
+			}
+		}
+		if (!min) return;
+		for (var i = 0; i < elements.length; i++) {
+			var el = elements[i];
+			if (parseFloat(getComputedStyle(el).fontSize) < min) {
+				el.dataset.webview2FontSize = el.style.fontSize;
+				el.style.setProperty("font-size", min + "px", "important");
+			}
+		}
+	}
+	function schedule() {
+		if (!scheduled) {
+			scheduled = true;
+			requestAnimationFrame(apply);
+		}
+	}
+	function start() {
+		schedule();
+		new MutationObserver(schedule).observe(document.body, {childList: true, subtree: true});
+	}
+	window.__webview2ApplyMinFontSize = schedule;
+	if (document.readyState === "loading") {
+		document.addEventListener("DOMContentLoaded", start);
+	} else {
+		start();
+	}
+})();`
+
+func (w *webview) SetMinimumFontSize(px int) {
+	if px < 0 {
+		px = 0
+	}
+	script := "window.__webview2MinFontSize = " + strconv.Itoa(px) + ";" + minFontSizeScript
+	w.replaceInit("minFontSize", script)
+	w.Eval(script)
+}
@@ -0,0 +1,70 @@
+//go:build windows
+// +build windows
+
+package webview2
+
+import (
+	"errors"
+
+	"github.com/mzky/go-webview2/internal/w32"
+)
+
+type scriptResult struct {
+	result string
+	err    error
+}
+
+func (w *webview) onMainThread() bool {
+	thread, _, _ := w32.Kernel32GetCurrentThreadID.Call()
+	return thread == w.mainThread
+}
+
+// sync runs f on the main thread and waits for it to return.
+func (w *webview) sync(f func()) {
+	if w.onMainThread() {
+		f()
+		return
+	}
+	done := make(chan struct{})
+	w.Dispatch(func() {
+		defer close(done)
+		f()
+	})
+	<-done
+}
+
+func (w *webview) FrameNames() []string {
+	var names []string
+	w.sync(func() {
+		for _, frame := range w.chromium().Frames() {
+			if name, err := frame.GetName(); err == nil {
+				names = append(names, name)
+			}
+		}
+	})
+	return names
+}
+
+func (w *webview) ExecuteScriptInFrame(frameName, js string) (string, error) {
+	if w.onMainThread() {
+		return "", errors.New("webview2: ExecuteScriptInFrame would block the main thread")
+	}
+	results := make(chan scriptResult, 1)
+	w.Dispatch(func() {
+		for _, frame := range w.chromium().Frames() {
+			if name, err := frame.GetName(); err != nil || name != frameName {
+				continue
+			}
+			err := w.chromium().ExecuteScriptInFrame(frame, js, func(result string, err error) {
+				results <- scriptResult{result, err}
+			})
+			if err != nil {
+				results <- scriptResult{"", err}
+			}
+			return
+		}
+		results <- scriptResult{"", errors.New("webview2: no frame named " + frameName)}
+	})
+	r := <-results
+	return r.result, r.err
+}
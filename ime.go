@@ -0,0 +1,23 @@
+//go:build windows
+// +build windows
+
+package webview2
+
+import "github.com/mzky/go-webview2/internal/w32"
+
+// isIMEMessage reports whether msg belongs to an input method composition,
+// as used for Chinese, Japanese and Korean input. IsDialogMessage must not
+// see these: it treats the keystrokes that commit or cancel a composition,
+// like Enter and Escape, as dialog navigation and swallows them before the
+// IME and the WebView2 control get them.
+func isIMEMessage(msg *w32.Msg) bool {
+	switch {
+	case msg.Message >= w32.WMImeStartComposition && msg.Message <= w32.WMImeKeyLast:
+		return true
+	case msg.Message >= w32.WMImeSetContext && msg.Message <= w32.WMImeKeyUp:
+		return true
+	case msg.Message == w32.WMKeyDown || msg.Message == w32.WMKeyUp:
+		return msg.WParam == w32.VKProcessKey
+	}
+	return false
+}
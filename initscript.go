@@ -0,0 +1,49 @@
+//go:build windows
+// +build windows
+
+package webview2
+
+// initScript tracks a document-created script that a setter replaces each
+// time it is called, so repeated calls don't pile up scripts that run on
+// every navigation.
+type initScript struct {
+	id  string // id of the registered script, empty while it is pending
+	gen int    // bumped on every replace, to spot superseded registrations
+}
+
+// replaceInit is like Init, but removes the script previously registered
+// under key first. It must be called on the main thread, where the
+// completion handlers run as well.
+func (w *webview) replaceInit(key, js string) {
+	w.checkThread("Init")
+	s := w.initScripts[key]
+	if s == nil {
+		if w.initScripts == nil {
+			w.initScripts = make(map[string]*initScript)
+		}
+		s = &initScript{}
+		w.initScripts[key] = s
+	}
+	chromium := w.chromium()
+	if s.id != "" {
+		_ = chromium.RemoveScriptToExecuteOnDocumentCreated(s.id)
+		s.id = ""
+	}
+	s.gen++
+	gen := s.gen
+	err := chromium.AddScriptToExecuteOnDocumentCreated(js, func(id string, err error) {
+		if err != nil {
+			return
+		}
+		if s.gen != gen {
+			// Replaced again before the id came back.
+			_ = chromium.RemoveScriptToExecuteOnDocumentCreated(id)
+			return
+		}
+		s.id = id
+	})
+	if err != nil {
+		// Fall back to a plain registration rather than losing the setting.
+		chromium.Init(js)
+	}
+}
@@ -0,0 +1,105 @@
+//go:build windows
+// +build windows
+
+package webview2
+
+import (
+	"errors"
+	"unsafe"
+
+	"github.com/lxn/win"
+)
+
+// MouseButton selects the button used by SendMouseInput.
+type MouseButton int
+
+const (
+	MouseLeft MouseButton = iota
+	MouseRight
+	MouseMiddle
+)
+
+// InputEventKind describes what SendMouseInput and SendKeyboardInput inject.
+// InputPress sends a down event immediately followed by an up event.
+type InputEventKind int
+
+const (
+	InputMove InputEventKind = iota
+	InputDown
+	InputUp
+	InputPress
+)
+
+var errInputBlocked = errors.New("webview2: input was blocked by another thread or UIPI")
+
+func (w *webview) SendMouseInput(x, y int, button MouseButton, kind InputEventKind) error {
+	var down, up uint32
+	switch button {
+	case MouseLeft:
+		down, up = win.MOUSEEVENTF_LEFTDOWN, win.MOUSEEVENTF_LEFTUP
+	case MouseRight:
+		down, up = win.MOUSEEVENTF_RIGHTDOWN, win.MOUSEEVENTF_RIGHTUP
+	case MouseMiddle:
+		down, up = win.MOUSEEVENTF_MIDDLEDOWN, win.MOUSEEVENTF_MIDDLEUP
+	default:
+		return errors.New("webview2: unknown mouse button")
+	}
+
+	var flags []uint32
+	switch kind {
+	case InputMove:
+		flags = []uint32{win.MOUSEEVENTF_MOVE}
+	case InputDown:
+		flags = []uint32{down}
+	case InputUp:
+		flags = []uint32{up}
+	case InputPress:
+		flags = []uint32{down, up}
+	default:
+		return errors.New("webview2: unknown input event kind")
+	}
+
+	// SendInput delivers to whatever window is under the cursor, so the
+	// cursor is placed on the requested point of the webview first.
+	pt := win.POINT{X: int32(x), Y: int32(y)}
+	win.ClientToScreen(w.GetHWnd(), &pt)
+	win.SetForegroundWindow(w.GetHWnd())
+	if !win.SetCursorPos(pt.X, pt.Y) {
+		return errInputBlocked
+	}
+
+	inputs := make([]win.MOUSE_INPUT, len(flags))
+	for i, f := range flags {
+		inputs[i] = win.MOUSE_INPUT{Type: win.INPUT_MOUSE, Mi: win.MOUSEINPUT{DwFlags: f}}
+	}
+	if win.SendInput(uint32(len(inputs)), unsafe.Pointer(&inputs[0]), int32(unsafe.Sizeof(inputs[0]))) != uint32(len(inputs)) {
+		return errInputBlocked
+	}
+	return nil
+}
+
+func (w *webview) SendKeyboardInput(virtualKey uint16, kind InputEventKind) error {
+	var flags []uint32
+	switch kind {
+	case InputDown:
+		flags = []uint32{0}
+	case InputUp:
+		flags = []uint32{win.KEYEVENTF_KEYUP}
+	case InputPress:
+		flags = []uint32{0, win.KEYEVENTF_KEYUP}
+	default:
+		return errors.New("webview2: unsupported keyboard event kind")
+	}
+
+	w.browser.Focus()
+	win.SetForegroundWindow(w.GetHWnd())
+
+	inputs := make([]win.KEYBD_INPUT, len(flags))
+	for i, f := range flags {
+		inputs[i] = win.KEYBD_INPUT{Type: win.INPUT_KEYBOARD, Ki: win.KEYBDINPUT{WVk: virtualKey, DwFlags: f}}
+	}
+	if win.SendInput(uint32(len(inputs)), unsafe.Pointer(&inputs[0]), int32(unsafe.Sizeof(inputs[0]))) != uint32(len(inputs)) {
+		return errInputBlocked
+	}
+	return nil
+}
@@ -11,10 +11,20 @@ import (
 var (
 	ole32               = windows.NewLazySystemDLL("ole32")
 	Ole32CoInitializeEx = ole32.NewProc("CoInitializeEx")
+	Ole32CoTaskMemAlloc = ole32.NewProc("CoTaskMemAlloc")
 
 	kernel32                   = windows.NewLazySystemDLL("kernel32")
 	Kernel32GetCurrentThreadID = kernel32.NewProc("GetCurrentThreadId")
 
+	dwmapi                      = windows.NewLazySystemDLL("dwmapi")
+	DwmapiDwmSetWindowAttribute = dwmapi.NewProc("DwmSetWindowAttribute")
+
+	gdi32                   = windows.NewLazySystemDLL("gdi32")
+	Gdi32CreateRoundRectRgn = gdi32.NewProc("CreateRoundRectRgn")
+
+	shell32                                        = windows.NewLazySystemDLL("shell32")
+	Shell32SetCurrentProcessExplicitAppUserModelID = shell32.NewProc("SetCurrentProcessExplicitAppUserModelID")
+
 	shlwapi                  = windows.NewLazySystemDLL("shlwapi")
 	shlwapiSHCreateMemStream = shlwapi.NewProc("SHCreateMemStream")
 
@@ -31,6 +41,7 @@ var (
 	User32TranslateMessage   = user32.NewProc("TranslateMessage")
 	User32DispatchMessageW   = user32.NewProc("DispatchMessageW")
 	User32DefWindowProcW     = user32.NewProc("DefWindowProcW")
+	User32SetWindowRgn       = user32.NewProc("SetWindowRgn")
 	User32GetClientRect      = user32.NewProc("GetClientRect")
 	User32PostQuitMessage    = user32.NewProc("PostQuitMessage")
 	User32PostMessageW       = user32.NewProc("PostMessageW")
@@ -42,6 +53,14 @@ var (
 	User32SetWindowPos       = user32.NewProc("SetWindowPos")
 	User32IsDialogMessage    = user32.NewProc("IsDialogMessage")
 	User32GetAncestor        = user32.NewProc("GetAncestor")
+	User32FlashWindowEx      = user32.NewProc("FlashWindowEx")
+	User32SetTimer           = user32.NewProc("SetTimer")
+	User32KillTimer          = user32.NewProc("KillTimer")
+	User32SendMessageW       = user32.NewProc("SendMessageW")
+
+	User32SetLayeredWindowAttributes = user32.NewProc("SetLayeredWindowAttributes")
+	User32EnumDisplayMonitors        = user32.NewProc("EnumDisplayMonitors")
+	User32SetWindowDisplayAffinity   = user32.NewProc("SetWindowDisplayAffinity")
 )
 
 const (
@@ -90,22 +109,60 @@ const (
 	SWPNoZOrder     = 0x0004
 	SWPNoActivate   = 0x0010
 	SWPNoMove       = 0x0002
+	SWPNoSize       = 0x0001
 	SWPFrameChanged = 0x0020
 )
 
 const (
-	WMPaint         = 0x000F
-	WMCreate        = 0x0001
-	WMDestroy       = 0x0002
-	WMMove          = 0x0003
-	WMSize          = 0x0005
-	WMActivate      = 0x0006
-	WMClose         = 0x0010
-	WMQuit          = 0x0012
-	WMGetMinMaxInfo = 0x0024
-	WMNCLButtonDown = 0x00A1
-	WMMoving        = 0x0216
-	WMApp           = 0x8000
+	WMPaint           = 0x000F
+	WMCreate          = 0x0001
+	WMDestroy         = 0x0002
+	WMMove            = 0x0003
+	WMSize            = 0x0005
+	WMActivate        = 0x0006
+	WMClose           = 0x0010
+	WMQueryEndSession = 0x0011
+	WMQuit            = 0x0012
+	WMEndSession      = 0x0016
+	WMSettingChange   = 0x001A
+	WMGetMinMaxInfo   = 0x0024
+	WMDisplayChange   = 0x007E
+	WMSetIcon         = 0x0080
+	WMNCDestroy       = 0x0082
+	WMNCLButtonDown   = 0x00A1
+	WMKeyDown         = 0x0100
+	WMKeyUp           = 0x0101
+	WMTimer           = 0x0113
+	WMMoving          = 0x0216
+	WMPowerBroadcast  = 0x0218
+	WMEnterSizeMove   = 0x0231
+	WMExitSizeMove    = 0x0232
+	WMApp             = 0x8000
+)
+
+// Ranges of the messages an input method editor sends while composing.
+const (
+	WMImeStartComposition = 0x010D
+	WMImeKeyLast          = 0x010F
+	WMImeSetContext       = 0x0281
+	WMImeKeyUp            = 0x0291
+)
+
+// VKProcessKey replaces the virtual key code of a keystroke that the input
+// method editor consumes.
+const VKProcessKey = 0xE5
+
+const (
+	DWMWAUseImmersiveDarkModeBefore20H1 = 19
+	DWMWAUseImmersiveDarkMode           = 20
+	DWMWACaptionColor                   = 35
+	DWMWAWindowCornerPreference         = 33
+	DWMWASystemBackdropType             = 38
+)
+
+const (
+	IconSmall = 0
+	IconBig   = 1
 )
 
 const (
@@ -115,7 +172,8 @@ const (
 )
 
 const (
-	GWLStyle = -16
+	GWLStyle   = -16
+	GWLExStyle = -20
 )
 
 const (
@@ -128,12 +186,40 @@ const (
 	WSOverlappedWindow = (WSOverlapped | WSCaption | WSSysMenu | WSThickFrame | WSMinimizeBox | WSMaximizeBox)
 )
 
+const (
+	WSExTransparent = 0x00000020
+	WSExToolWindow  = 0x00000080
+	WSExLayered     = 0x00080000
+)
+
+const (
+	LWAColorKey = 0x1
+	LWAAlpha    = 0x2
+)
+
 const (
 	WAInactive    = 0
 	WAActive      = 1
 	WAActiveClick = 2
 )
 
+const (
+	FlashWStop      = 0x0
+	FlashWCaption   = 0x1
+	FlashWTray      = 0x2
+	FlashWAll       = 0x3
+	FlashWTimer     = 0x4
+	FlashWTimerNoFG = 0xC
+)
+
+type FlashWInfo struct {
+	CbSize    uint32
+	Hwnd      uintptr
+	DwFlags   uint32
+	UCount    uint32
+	DwTimeout uint32
+}
+
 type WndClassExW struct {
 	CbSize        uint32
 	Style         uint32
@@ -193,14 +279,54 @@ func Utf16PtrToString(p *uint16) string {
 	return string(utf16.Decode(s))
 }
 
-func SHCreateMemStream(data []byte) (uintptr, error) {
+// SHCreateMemStream creates a memory stream holding a copy of data and
+// returns its IStream. An empty data creates an empty stream that can be
+// written to.
+func SHCreateMemStream(data []byte) (unsafe.Pointer, error) {
+	var p unsafe.Pointer
+	if len(data) > 0 {
+		p = unsafe.Pointer(&data[0])
+	}
 	ret, _, err := shlwapiSHCreateMemStream.Call(
-		uintptr(unsafe.Pointer(&data[0])),
+		uintptr(p),
 		uintptr(len(data)),
 	)
 	if ret == 0 {
-		return 0, err
+		return nil, err
+	}
+
+	return *(*unsafe.Pointer)(unsafe.Pointer(&ret)), nil
+}
+
+// CoTaskMemAlloc allocates memory that is owned by the caller of a COM method
+// and released by it with CoTaskMemFree.
+func CoTaskMemAlloc(size uintptr) unsafe.Pointer {
+	r, _, _ := Ole32CoTaskMemAlloc.Call(size)
+	return *(*unsafe.Pointer)(unsafe.Pointer(&r))
+}
+
+// CoTaskMemString copies s into a NUL terminated UTF-16 string allocated with
+// CoTaskMemAlloc, as expected from COM methods returning an LPWSTR.
+func CoTaskMemString(s string) *uint16 {
+	u := utf16.Encode([]rune(s + "\x00"))
+	p := (*uint16)(CoTaskMemAlloc(uintptr(len(u)) * unsafe.Sizeof(u[0])))
+	if p == nil {
+		return nil
 	}
+	copy(unsafe.Slice(p, len(u)), u)
+	return p
+}
 
-	return ret, nil
+// DwmSetWindowAttribute sets a 32-bit DWM window attribute.
+func DwmSetWindowAttribute(hwnd uintptr, attribute uint32, value uint32) error {
+	hr, _, _ := DwmapiDwmSetWindowAttribute.Call(
+		hwnd,
+		uintptr(attribute),
+		uintptr(unsafe.Pointer(&value)),
+		unsafe.Sizeof(value),
+	)
+	if int32(hr) < 0 {
+		return syscall.Errno(hr)
+	}
+	return nil
 }
@@ -0,0 +1,68 @@
+//go:build windows
+// +build windows
+
+package webview2
+
+import (
+	"encoding/json"
+	"log"
+)
+
+// jsErrorMethod is the RPC method name under which the page reports
+// uncaught errors. It is handled before bindings and the origin filter, so
+// that reporting an error can't cause another one.
+const jsErrorMethod = "__webview2_error"
+
+const jsErrorScript = `(function() {
+	if (window.__webview2JSErrors) return;
+	window.__webview2JSErrors = true;
+	function report(message, source, line, stack) {
+		try {
+			window.external.invoke(JSON.stringify({
+				id: 0,
+				method: "` + jsErrorMethod + `",
+				params: [String(message), String(source || ""), line || 0, String(stack || "")]
+			}));
+		} catch (e) {}
+	}
+	window.addEventListener("error", function(e) {
+		report(e.message, e.filename, e.lineno, e.error && e.error.stack);
+	});
+	window.addEventListener("unhandledrejection", function(e) {
+		var reason = e.reason;
+		var message = reason && reason.message !== undefined ? reason.message : reason;
+		report("Uncaught (in promise) " + message, "", 0, reason && reason.stack);
+	});
+})();`
+
+func (w *webview) SetJSErrorHandler(fn func(message, source string, line int, stack string)) {
+	w.m.Lock()
+	install := fn != nil && !w.jsErrorHooked
+	if install {
+		w.jsErrorHooked = true
+	}
+	w.jsErrorHandler = fn
+	w.m.Unlock()
+	if install {
+		w.Init(jsErrorScript)
+		w.Eval(jsErrorScript)
+	}
+}
+
+func (w *webview) jsError(params []json.RawMessage) {
+	w.m.Lock()
+	fn := w.jsErrorHandler
+	w.m.Unlock()
+	if fn == nil || len(params) != 4 {
+		return
+	}
+	var message, source, stack string
+	var line int
+	for i, v := range []interface{}{&message, &source, &line, &stack} {
+		if err := json.Unmarshal(params[i], v); err != nil {
+			log.Printf("invalid JS error report: %v", err)
+			return
+		}
+	}
+	fn(message, source, line, stack)
+}
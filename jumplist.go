@@ -0,0 +1,331 @@
+//go:build windows
+// +build windows
+
+package webview2
+
+import (
+	"os"
+	"runtime"
+	"syscall"
+	"unsafe"
+
+	"github.com/lxn/win"
+	"golang.org/x/sys/windows"
+)
+
+// JumpListItem is an entry of a jump list. Clicking it starts Path, or the
+// running executable if Path is empty, with Arguments.
+type JumpListItem struct {
+	Title       string
+	Arguments   string
+	Description string // shown as tooltip
+	Path        string
+	IconPath    string
+	IconIndex   int
+}
+
+// JumpListCategory is a named group of items in a jump list.
+type JumpListCategory struct {
+	Name  string
+	Items []JumpListItem
+}
+
+// JumpList describes the menu shown when right-clicking the application's
+// taskbar button. It is built up with AddTask and AddCategory and replaces
+// the current jump list on Commit.
+type JumpList struct {
+	Tasks      []JumpListItem
+	Categories []JumpListCategory
+
+	// ShowRecent and ShowFrequent add the categories Windows maintains for
+	// files opened with the application. They stay empty unless the
+	// application is registered as a handler for the file types.
+	ShowRecent   bool
+	ShowFrequent bool
+}
+
+// AddTask appends an item to the Tasks category.
+func (j *JumpList) AddTask(item JumpListItem) *JumpList {
+	j.Tasks = append(j.Tasks, item)
+	return j
+}
+
+// AddCategory appends a custom category.
+func (j *JumpList) AddCategory(name string, items ...JumpListItem) *JumpList {
+	j.Categories = append(j.Categories, JumpListCategory{Name: name, Items: items})
+	return j
+}
+
+var (
+	clsidDestinationList            = win.CLSID(mustGUID("{77f10cf0-3db5-4966-b520-b7c54fd35ed6}"))
+	iidICustomDestinationList       = mustGUID("{6332debf-87b5-4670-90c0-5e57b408a49e}")
+	clsidEnumerableObjectCollection = win.CLSID(mustGUID("{2d3468c1-36a7-43b6-ac24-d3f02fd9607a}"))
+	iidIObjectCollection            = mustGUID("{5632b1a4-e38a-400a-928a-d4cd63230295}")
+	iidIObjectArray                 = mustGUID("{92ca9dcd-5622-4bba-a805-5e9f541bd8c9}")
+	clsidShellLink                  = win.CLSID(mustGUID("{00021401-0000-0000-c000-000000000046}"))
+	iidIShellLinkW                  = mustGUID("{000214f9-0000-0000-c000-000000000046}")
+	iidIPropertyStore               = mustGUID("{886d8eeb-8cf2-4446-8d02-cdba1dbdcf99}")
+	pkeyTitle                       = propertyKey{fmtid: mustGUID("{f29f85e0-4ff9-1068-ab91-08002b27b3d9}"), pid: 2}
+)
+
+func mustGUID(s string) win.IID {
+	guid, err := windows.GUIDFromString(s)
+	if err != nil {
+		panic(err)
+	}
+	return win.IID(guid)
+}
+
+// Vtable indices of the methods used below.
+const (
+	methodQueryInterface = 0
+	methodRelease        = 2
+
+	destListSetAppID            = 3
+	destListBeginList           = 4
+	destListAppendCategory      = 5
+	destListAppendKnownCategory = 6
+	destListAddUserTasks        = 7
+	destListCommitList          = 8
+	destListDeleteList          = 10
+	destListAbortList           = 11
+
+	collectionAddObject = 5
+
+	shellLinkSetDescription  = 7
+	shellLinkSetArguments    = 11
+	shellLinkSetIconLocation = 17
+	shellLinkSetPath         = 20
+
+	propertyStoreSetValue = 6
+	propertyStoreCommit   = 7
+)
+
+const (
+	kdcFrequent = 1
+	kdcRecent   = 2
+	vtLPWStr    = 31
+)
+
+type propertyKey struct {
+	fmtid win.IID
+	pid   uint32
+}
+
+// propVariant is a PROPVARIANT holding a pointer, which is all that is needed
+// to set string properties.
+type propVariant struct {
+	vt       uint16
+	reserved [3]uint16
+	pointer  uintptr
+	_        uintptr
+}
+
+// comObject is a COM interface pointer whose methods are called by vtable
+// index.
+type comObject struct {
+	vtbl *[32]uintptr
+}
+
+func (o *comObject) call(method int, args ...uintptr) error {
+	hr, _, _ := syscall.SyscallN(o.vtbl[method], append([]uintptr{uintptr(unsafe.Pointer(o))}, args...)...)
+	if int32(hr) < 0 {
+		return syscall.Errno(uint32(hr))
+	}
+	return nil
+}
+
+// callString is like call, but passes s as a UTF-16 string in front of args.
+func (o *comObject) callString(method int, s string, args ...uintptr) error {
+	_s, err := windows.UTF16PtrFromString(s)
+	if err != nil {
+		return err
+	}
+	err = o.call(method, append([]uintptr{uintptr(unsafe.Pointer(_s))}, args...)...)
+	runtime.KeepAlive(_s)
+	return err
+}
+
+func (o *comObject) release() {
+	_, _, _ = syscall.SyscallN(o.vtbl[methodRelease], uintptr(unsafe.Pointer(o)))
+}
+
+func (o *comObject) queryInterface(iid *win.IID) (*comObject, error) {
+	var result *comObject
+	err := o.call(methodQueryInterface, uintptr(unsafe.Pointer(iid)), uintptr(unsafe.Pointer(&result)))
+	return result, err
+}
+
+func createInstance(clsid *win.CLSID, iid *win.IID) (*comObject, error) {
+	var result *comObject
+	hr := win.CoCreateInstance(clsid, nil, win.CLSCTX_INPROC_SERVER, iid, (*unsafe.Pointer)(unsafe.Pointer(&result)))
+	if win.FAILED(hr) {
+		return nil, syscall.Errno(uint32(hr))
+	}
+	return result, nil
+}
+
+// Commit replaces the application's jump list. It has to be called on the UI
+// thread. If the user removed an item of a custom category from the jump
+// list, Windows refuses that category; the other categories are still
+// committed and the error is returned.
+func (j *JumpList) Commit() error {
+	list, err := createInstance(&clsidDestinationList, &iidICustomDestinationList)
+	if err != nil {
+		return err
+	}
+	defer list.release()
+
+	if appUserModelID != "" {
+		if err := list.callString(destListSetAppID, appUserModelID); err != nil {
+			return err
+		}
+	}
+
+	var minSlots uint32
+	var removed *comObject
+	if err := list.call(destListBeginList, uintptr(unsafe.Pointer(&minSlots)), uintptr(unsafe.Pointer(&iidIObjectArray)), uintptr(unsafe.Pointer(&removed))); err != nil {
+		return err
+	}
+	removed.release()
+
+	categoryErr := j.appendCategories(list)
+	if err := j.appendTasks(list); err != nil {
+		_ = list.call(destListAbortList)
+		return err
+	}
+	if err := list.call(destListCommitList); err != nil {
+		return err
+	}
+	return categoryErr
+}
+
+func (j *JumpList) appendCategories(list *comObject) error {
+	var firstErr error
+	keep := func(err error) {
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+	if j.ShowFrequent {
+		keep(list.call(destListAppendKnownCategory, kdcFrequent))
+	}
+	if j.ShowRecent {
+		keep(list.call(destListAppendKnownCategory, kdcRecent))
+	}
+	for _, category := range j.Categories {
+		items, err := shellLinkCollection(category.Items)
+		if err != nil {
+			keep(err)
+			continue
+		}
+		keep(list.callString(destListAppendCategory, category.Name, uintptr(unsafe.Pointer(items))))
+		items.release()
+	}
+	return firstErr
+}
+
+func (j *JumpList) appendTasks(list *comObject) error {
+	if len(j.Tasks) == 0 {
+		return nil
+	}
+	tasks, err := shellLinkCollection(j.Tasks)
+	if err != nil {
+		return err
+	}
+	defer tasks.release()
+	return list.call(destListAddUserTasks, uintptr(unsafe.Pointer(tasks)))
+}
+
+// shellLinkCollection returns an IObjectArray with a shell link for each item.
+func shellLinkCollection(items []JumpListItem) (*comObject, error) {
+	collection, err := createInstance(&clsidEnumerableObjectCollection, &iidIObjectCollection)
+	if err != nil {
+		return nil, err
+	}
+	defer collection.release()
+
+	for _, item := range items {
+		link, err := newShellLink(item)
+		if err != nil {
+			return nil, err
+		}
+		err = collection.call(collectionAddObject, uintptr(unsafe.Pointer(link)))
+		link.release()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return collection.queryInterface(&iidIObjectArray)
+}
+
+func newShellLink(item JumpListItem) (*comObject, error) {
+	path := item.Path
+	if path == "" {
+		executable, err := os.Executable()
+		if err != nil {
+			return nil, err
+		}
+		path = executable
+	}
+
+	link, err := createInstance(&clsidShellLink, &iidIShellLinkW)
+	if err != nil {
+		return nil, err
+	}
+	err = link.callString(shellLinkSetPath, path)
+	if err == nil {
+		err = link.callString(shellLinkSetArguments, item.Arguments)
+	}
+	if err == nil && item.Description != "" {
+		err = link.callString(shellLinkSetDescription, item.Description)
+	}
+	if err == nil && item.IconPath != "" {
+		err = link.callString(shellLinkSetIconLocation, item.IconPath, uintptr(item.IconIndex))
+	}
+	if err == nil {
+		err = setShellLinkTitle(link, item.Title)
+	}
+	if err != nil {
+		link.release()
+		return nil, err
+	}
+	return link, nil
+}
+
+// setShellLinkTitle sets the text shown for a link, which is a property of
+// the link rather than a field of IShellLink.
+func setShellLinkTitle(link *comObject, title string) error {
+	store, err := link.queryInterface(&iidIPropertyStore)
+	if err != nil {
+		return err
+	}
+	defer store.release()
+
+	_title, err := windows.UTF16PtrFromString(title)
+	if err != nil {
+		return err
+	}
+	value := propVariant{vt: vtLPWStr, pointer: uintptr(unsafe.Pointer(_title))}
+	err = store.call(propertyStoreSetValue, uintptr(unsafe.Pointer(&pkeyTitle)), uintptr(unsafe.Pointer(&value)))
+	// value only holds the title as a uintptr.
+	runtime.KeepAlive(_title)
+	if err != nil {
+		return err
+	}
+	return store.call(propertyStoreCommit)
+}
+
+// ClearJumpList removes the jump list set by JumpList.Commit.
+func ClearJumpList() error {
+	list, err := createInstance(&clsidDestinationList, &iidICustomDestinationList)
+	if err != nil {
+		return err
+	}
+	defer list.release()
+
+	if appUserModelID == "" {
+		return list.call(destListDeleteList, 0)
+	}
+	return list.callString(destListDeleteList, appUserModelID)
+}
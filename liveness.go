@@ -0,0 +1,78 @@
+//go:build windows
+// +build windows
+
+package webview2
+
+import (
+	"time"
+
+	"github.com/mzky/go-webview2/internal/w32"
+)
+
+// livenessTimerID identifies the timer that pings the page for
+// SetUnresponsiveHandler.
+const livenessTimerID = 2
+
+const (
+	livenessInterval = 1000 // ms
+	// livenessTimeout is how long a ping may stay unanswered before the page
+	// is reported as unresponsive.
+	livenessTimeout = 5 * time.Second
+)
+
+// liveness pings the page with an empty script, which only completes once
+// the render process has run it.
+type liveness struct {
+	enter, leave func()
+	pending      bool
+	sent         time.Time
+	unresponsive bool
+}
+
+func (w *webview) SetUnresponsiveHandler(enter, leave func()) {
+	// The timer only works from the window's thread, and wndProc reads
+	// w.liveness there.
+	w.sync(func() {
+		if enter == nil && leave == nil {
+			w.liveness = nil
+			_, _, _ = w32.User32KillTimer.Call(w.hWnd, livenessTimerID)
+			return
+		}
+		if w.liveness == nil {
+			w.liveness = &liveness{}
+			_, _, _ = w32.User32SetTimer.Call(w.hWnd, livenessTimerID, livenessInterval, 0)
+		}
+		w.liveness.enter = enter
+		w.liveness.leave = leave
+	})
+}
+
+func (w *webview) pingPage() {
+	l := w.liveness
+	if l == nil {
+		return
+	}
+	if l.pending {
+		if !l.unresponsive && time.Since(l.sent) > livenessTimeout {
+			l.unresponsive = true
+			if l.enter != nil {
+				l.enter()
+			}
+		}
+		return
+	}
+	l.pending = true
+	l.sent = time.Now()
+	err := w.chromium().ExecuteScript("0", func(string, error) {
+		l.pending = false
+		if l.unresponsive {
+			l.unresponsive = false
+			if l.leave != nil {
+				l.leave()
+			}
+		}
+	})
+	if err != nil {
+		l.pending = false
+	}
+}
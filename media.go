@@ -0,0 +1,86 @@
+//go:build windows
+// +build windows
+
+package webview2
+
+import "github.com/mzky/go-webview2/pkg/edge"
+
+// pauseMediaScript pauses every playing <audio> and <video> element of the
+// document and remembers them, so that resumeMediaScript only restarts what
+// was actually playing before.
+const pauseMediaScript = `(function() {
+	var paused = window.__webview2PausedMedia = window.__webview2PausedMedia || [];
+	document.querySelectorAll("audio, video").forEach(function(media) {
+		if (!media.paused && !media.ended) {
+			media.pause();
+			paused.push(media);
+		}
+	});
+})();`
+
+const resumeMediaScript = `(function() {
+	var paused = window.__webview2PausedMedia || [];
+	window.__webview2PausedMedia = [];
+	paused.forEach(function(media) {
+		var playing = media.play();
+		if (playing && playing.catch) playing.catch(function() {});
+	});
+})();`
+
+// AutoplayPolicy controls when pages may start playing media on their own.
+type AutoplayPolicy int
+
+const (
+	// AutoplayDefault keeps the runtime's default policy.
+	AutoplayDefault AutoplayPolicy = iota
+	// AutoplayAllow lets media play without any user interaction.
+	AutoplayAllow
+	// AutoplayRequireUserGesture requires a user gesture in the document or
+	// a frame that navigated to it before media may play.
+	AutoplayRequireUserGesture
+	// AutoplayBlock is the strictest policy the runtime offers: media only
+	// plays after the user has interacted with the document itself.
+	AutoplayBlock
+)
+
+var autoplayPolicies = map[AutoplayPolicy]string{
+	AutoplayAllow:              "no-user-gesture-required",
+	AutoplayRequireUserGesture: "user-gesture-required",
+	AutoplayBlock:              "document-user-activation-required",
+}
+
+// SetAutoplayPolicy sets the autoplay policy of the browser process. It is
+// passed as a command line switch, so it must be called before the webview is
+// created and applies to all webviews sharing the same data path.
+func SetAutoplayPolicy(policy AutoplayPolicy) {
+	if value, ok := autoplayPolicies[policy]; ok {
+		setBrowserArgument("autoplay-policy", value)
+	} else {
+		removeBrowserArgument("autoplay-policy")
+	}
+}
+
+func (w *webview) SetMuted(muted bool) error {
+	webview8 := w.chromium().GetICoreWebView2_8()
+	if webview8 == nil {
+		return &edge.FeatureUnavailableError{Interface: "ICoreWebView2_8"}
+	}
+	defer webview8.Release()
+	return webview8.PutIsMuted(muted)
+}
+
+func (w *webview) PauseAllMedia() {
+	w.Eval(pauseMediaScript)
+	// Sources that are not media elements, like Web Audio, can't be paused
+	// from script, so the document is muted as well when supported.
+	_ = w.SetMuted(true)
+}
+
+func (w *webview) ResumeAllMedia() {
+	_ = w.SetMuted(false)
+	w.Eval(resumeMediaScript)
+}
+
+func (w *webview) SetAudioPlaybackChangedHandler(fn func(playing bool)) {
+	w.chromium().AudioPlaybackChangedCallback = fn
+}
@@ -0,0 +1,60 @@
+//go:build windows
+// +build windows
+
+package webview2
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"reflect"
+)
+
+func (w *webview) OnMessage(handler func(raw json.RawMessage)) {
+	w.m.Lock()
+	w.msgHandlers = append(w.msgHandlers, handler)
+	w.m.Unlock()
+	w.chromium().WebMessageJSONCallback = w.jsonMessage
+}
+
+func (w *webview) OnMessageTyped(prototype interface{}, handler func(interface{})) error {
+	if prototype == nil {
+		return errors.New("webview2: OnMessageTyped needs a non-nil prototype")
+	}
+	t := reflect.TypeOf(prototype)
+	isPtr := t.Kind() == reflect.Ptr
+	if isPtr {
+		t = t.Elem()
+	}
+	w.OnMessage(func(raw json.RawMessage) {
+		v := reflect.New(t)
+		if err := json.Unmarshal(raw, v.Interface()); err != nil {
+			log.Printf("unable to decode web message into %s: %v", t, err)
+			return
+		}
+		if isPtr {
+			handler(v.Interface())
+		} else {
+			handler(v.Elem().Interface())
+		}
+	})
+	return nil
+}
+
+func (w *webview) jsonMessage(message string) {
+	// String messages that decode as RPC calls belong to the bindings.
+	var s string
+	if json.Unmarshal([]byte(message), &s) == nil {
+		var rpc rpcMessage
+		if json.Unmarshal([]byte(s), &rpc) == nil && rpc.Method != "" {
+			return
+		}
+	}
+
+	w.m.Lock()
+	handlers := w.msgHandlers
+	w.m.Unlock()
+	for _, handler := range handlers {
+		handler(json.RawMessage(message))
+	}
+}
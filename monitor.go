@@ -0,0 +1,80 @@
+//go:build windows
+// +build windows
+
+package webview2
+
+import (
+	"errors"
+	"sync"
+	"unsafe"
+
+	"github.com/lxn/win"
+	"github.com/mzky/go-webview2/internal/w32"
+	"golang.org/x/sys/windows"
+)
+
+// MonitorInfo describes a display in virtual screen coordinates.
+type MonitorInfo struct {
+	Bounds   win.RECT
+	WorkArea win.RECT
+	Primary  bool
+}
+
+var (
+	// monitorEnumProc is created once because Windows callbacks are never
+	// freed. It appends to enumMonitors, which monitorsSync guards.
+	monitorEnumProc = windows.NewCallback(func(hMonitor, hdc, clip, data uintptr) uintptr {
+		enumMonitors = append(enumMonitors, win.HMONITOR(hMonitor))
+		return 1
+	})
+	enumMonitors []win.HMONITOR
+	monitorsSync sync.Mutex
+)
+
+func monitors() []MonitorInfo {
+	monitorsSync.Lock()
+	enumMonitors = nil
+	_, _, _ = w32.User32EnumDisplayMonitors.Call(0, 0, monitorEnumProc, 0)
+	handles := enumMonitors
+	monitorsSync.Unlock()
+
+	result := make([]MonitorInfo, 0, len(handles))
+	for _, handle := range handles {
+		info := win.MONITORINFO{}
+		info.CbSize = uint32(unsafe.Sizeof(info))
+		if !win.GetMonitorInfo(handle, &info) {
+			continue
+		}
+		result = append(result, MonitorInfo{
+			Bounds:   info.RcMonitor,
+			WorkArea: info.RcWork,
+			Primary:  info.DwFlags&win.MONITORINFOF_PRIMARY != 0,
+		})
+	}
+	return result
+}
+
+func (w *webview) Monitors() []MonitorInfo {
+	return monitors()
+}
+
+func (w *webview) MoveToMonitor(index int, center bool) error {
+	all := monitors()
+	if index < 0 || index >= len(all) {
+		return errors.New("webview2: monitor index out of range")
+	}
+	work := all[index].WorkArea
+
+	rect := win.RECT{}
+	win.GetWindowRect(w.GetHWnd(), &rect)
+	width := rect.Right - rect.Left
+	height := rect.Bottom - rect.Top
+
+	x, y := work.Left, work.Top
+	if center {
+		x += (work.Right - work.Left - width) / 2
+		y += (work.Bottom - work.Top - height) / 2
+	}
+	win.MoveWindow(w.GetHWnd(), x, y, width, height, true)
+	return nil
+}
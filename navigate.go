@@ -0,0 +1,156 @@
+//go:build windows
+// +build windows
+
+package webview2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/mzky/go-webview2/pkg/edge"
+)
+
+// NavigationError is returned by NavigateContext when the navigation failed.
+type NavigationError struct {
+	Status edge.COREWEBVIEW2_WEB_ERROR_STATUS
+}
+
+func (e *NavigationError) Error() string {
+	return fmt.Sprintf("webview2: navigation failed with web error status %d", e.Status)
+}
+
+func (w *webview) NavigateContext(ctx context.Context, uri string) error {
+	if w.onMainThread() {
+		return errors.New("webview2: NavigateContext would block the main thread")
+	}
+	done := make(chan error, 1)
+	w.Dispatch(func() {
+		w.startNavigation(uri, done)
+	})
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		w.Dispatch(func() {
+			w.removeNavWaiter(done)
+			w.chromium().Stop()
+		})
+		return ctx.Err()
+	}
+}
+
+// startNavigation navigates to uri and sends the outcome of the navigation,
+// or the error of the runtime refusing it, to done.
+func (w *webview) startNavigation(uri string, done chan error) {
+	w.addNavWaiter(uri, done)
+	if err := w.browser.Navigate(uri); err != nil {
+		w.removeNavWaiter(done)
+		done <- fmt.Errorf("webview2: navigating to %s: %w", uri, err)
+	}
+}
+
+// navWaiter is a NavigateContext call waiting for the completion of its
+// navigation. The navigation ID is only known once the NavigationStarting
+// event for uri has been raised.
+type navWaiter struct {
+	uri     string
+	id      uint64
+	started bool
+	done    chan error
+}
+
+func (w *webview) addNavWaiter(uri string, done chan error) {
+	w.navWaiters = append(w.navWaiters, &navWaiter{uri: uri, done: done})
+}
+
+func (w *webview) removeNavWaiter(done chan error) {
+	for i, waiter := range w.navWaiters {
+		if waiter.done == done {
+			w.navWaiters = append(w.navWaiters[:i], w.navWaiters[i+1:]...)
+			return
+		}
+	}
+}
+
+func (w *webview) navigationStarting(_ *edge.ICoreWebView2, args *edge.ICoreWebView2NavigationStartingEventArgs) {
+	w.restrictNavigation(args)
+
+	uri, err := args.GetUri()
+	if err != nil {
+		return
+	}
+	id, err := args.GetNavigationId()
+	if err != nil {
+		return
+	}
+	var first *navWaiter
+	for _, waiter := range w.navWaiters {
+		if waiter.started {
+			continue
+		}
+		if sameURL(waiter.uri, uri) {
+			waiter.id, waiter.started = id, true
+			return
+		}
+		if first == nil {
+			first = waiter
+		}
+	}
+	// The browser may rewrite the URL beyond what normalizeURL undoes, e.g.
+	// IDN hosts or percent-encoding, so a navigation that matches no waiter
+	// belongs to the oldest one that hasn't started yet.
+	if first != nil {
+		first.id, first.started = id, true
+	}
+}
+
+func (w *webview) navigationCompleted(_ *edge.ICoreWebView2, args *edge.ICoreWebView2NavigationCompletedEventArgs) {
+	var err error
+	success, _ := args.GetIsSuccess()
+	if !success {
+		status, _ := args.GetWebErrorStatus()
+		err = &NavigationError{Status: status}
+		w.showOfflinePage(status)
+	}
+	// Only the waiter of this navigation is resolved, so that the
+	// completion of a cancelled earlier navigation or of SetHtml isn't
+	// mistaken for it.
+	id, idErr := args.GetNavigationId()
+	if idErr != nil {
+		return
+	}
+	for i, waiter := range w.navWaiters {
+		if waiter.started && waiter.id == id {
+			waiter.done <- err
+			w.navWaiters = append(w.navWaiters[:i], w.navWaiters[i+1:]...)
+			return
+		}
+	}
+}
+
+// sameURL reports whether a and b name the same resource, ignoring the
+// normalization the browser applies to the requested URL, e.g. the trailing
+// slash of "https://example.com".
+func sameURL(a, b string) bool {
+	return normalizeURL(a) == normalizeURL(b)
+}
+
+func normalizeURL(uri string) string {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return uri
+	}
+	u.Scheme = strings.ToLower(u.Scheme)
+	u.Host = strings.ToLower(u.Host)
+	if port := u.Port(); (u.Scheme == "http" && port == "80") || (u.Scheme == "https" && port == "443") {
+		u.Host = u.Hostname()
+	}
+	if u.Path == "" && u.Host != "" {
+		u.Path = "/"
+	}
+	u.Fragment = ""
+	return u.String()
+}
@@ -0,0 +1,107 @@
+//go:build windows
+// +build windows
+
+package webview2
+
+import (
+	"errors"
+	"unsafe"
+
+	"github.com/lxn/win"
+	"golang.org/x/sys/windows"
+)
+
+// notificationShim replaces the page's Notification API, which WebView2
+// silently drops, with one that forwards notifications to the bound
+// __webview2Notify function.
+const notificationShim = `(function() {
+	function Notification(title, options) {
+		options = options || {};
+		this.title = String(title);
+		this.body = String(options.body || "");
+		this.icon = String(options.icon || "");
+		window.__webview2Notify(this.title, this.body, this.icon);
+	}
+	Notification.permission = "granted";
+	Notification.requestPermission = function(callback) {
+		if (callback) callback("granted");
+		return Promise.resolve("granted");
+	};
+	Notification.prototype.close = function() {};
+	window.Notification = Notification;
+})();`
+
+// notifyIconID identifies the notification area icon used by ShowNotification.
+const notifyIconID = 1
+
+func (w *webview) SetNotificationHandler(fn func(title, body, icon string)) error {
+	w.m.Lock()
+	installed := w.notify != nil
+	w.notify = fn
+	w.m.Unlock()
+	if installed || fn == nil {
+		return nil
+	}
+
+	err := w.Bind("__webview2Notify", func(title, body, icon string) {
+		w.m.Lock()
+		notify := w.notify
+		w.m.Unlock()
+		if notify != nil {
+			notify(title, body, icon)
+		}
+	})
+	if err != nil {
+		return err
+	}
+	w.Init(notificationShim)
+	w.Eval(notificationShim)
+	return nil
+}
+
+func (w *webview) ShowNotification(title, body string) error {
+	// Both usually come from the page, which must not be able to crash the
+	// UI thread with a NUL.
+	_title, err := windows.UTF16FromString(title)
+	if err != nil {
+		return err
+	}
+	_body, err := windows.UTF16FromString(body)
+	if err != nil {
+		return err
+	}
+
+	data := win.NOTIFYICONDATA{
+		HWnd:        w.GetHWnd(),
+		UID:         notifyIconID,
+		UFlags:      win.NIF_ICON | win.NIF_INFO,
+		DwInfoFlags: win.NIIF_INFO,
+	}
+	data.CbSize = uint32(unsafe.Sizeof(data))
+	data.HIcon = win.HICON(win.SendMessage(w.GetHWnd(), win.WM_GETICON, 2, 0))
+	if data.HIcon == 0 {
+		data.HIcon = win.LoadIcon(0, win.MAKEINTRESOURCE(win.IDI_APPLICATION))
+	}
+	copy(data.SzInfoTitle[:len(data.SzInfoTitle)-1], _title)
+	copy(data.SzInfo[:len(data.SzInfo)-1], _body)
+
+	message := uint32(win.NIM_MODIFY)
+	if !w.notifyIcon {
+		message = win.NIM_ADD
+	}
+	if !win.Shell_NotifyIcon(message, &data) {
+		return errors.New("webview2: unable to show the notification")
+	}
+	w.notifyIcon = true
+	return nil
+}
+
+func (w *webview) removeNotifyIcon() {
+	if !w.notifyIcon {
+		return
+	}
+	data := win.NOTIFYICONDATA{HWnd: w.GetHWnd(), UID: notifyIconID}
+	data.CbSize = uint32(unsafe.Sizeof(data))
+	win.Shell_NotifyIcon(win.NIM_DELETE, &data)
+	w.notifyIcon = false
+}
@@ -0,0 +1,29 @@
+//go:build windows
+// +build windows
+
+package webview2
+
+import "github.com/mzky/go-webview2/pkg/edge"
+
+// offlineStatuses are the navigation errors caused by a missing or broken
+// connection, for which the offline page replaces the browser error page.
+var offlineStatuses = map[edge.COREWEBVIEW2_WEB_ERROR_STATUS]bool{
+	edge.COREWEBVIEW2_WEB_ERROR_STATUS_SERVER_UNREACHABLE:     true,
+	edge.COREWEBVIEW2_WEB_ERROR_STATUS_TIMEOUT:                true,
+	edge.COREWEBVIEW2_WEB_ERROR_STATUS_CONNECTION_ABORTED:     true,
+	edge.COREWEBVIEW2_WEB_ERROR_STATUS_CONNECTION_RESET:       true,
+	edge.COREWEBVIEW2_WEB_ERROR_STATUS_DISCONNECTED:           true,
+	edge.COREWEBVIEW2_WEB_ERROR_STATUS_CANNOT_CONNECT:         true,
+	edge.COREWEBVIEW2_WEB_ERROR_STATUS_HOST_NAME_NOT_RESOLVED: true,
+}
+
+func (w *webview) SetOfflineHTML(html string) {
+	w.offlineHTML = html
+}
+
+// showOfflinePage shows the offline page if status is a connection error.
+func (w *webview) showOfflinePage(status edge.COREWEBVIEW2_WEB_ERROR_STATUS) {
+	if w.offlineHTML != "" && offlineStatuses[status] {
+		w.browser.NavigateToString(w.offlineHTML)
+	}
+}
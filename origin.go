@@ -0,0 +1,69 @@
+//go:build windows
+// +build windows
+
+package webview2
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/lxn/win"
+	"github.com/mzky/go-webview2/pkg/edge"
+	"golang.org/x/sys/windows"
+)
+
+func (w *webview) RestrictToOrigin(origin string, openExternal bool) {
+	w.allowedOrigin = ""
+	if origin != "" {
+		w.allowedOrigin = originOf(origin)
+	}
+	w.openExternal = openExternal
+}
+
+// restrictNavigation cancels the navigation if it leaves the origin set with
+// RestrictToOrigin.
+func (w *webview) restrictNavigation(args *edge.ICoreWebView2NavigationStartingEventArgs) {
+	if w.allowedOrigin == "" {
+		return
+	}
+	uri, err := args.GetUri()
+	if err != nil {
+		return
+	}
+	u, err := url.Parse(uri)
+	if err != nil {
+		return
+	}
+	switch strings.ToLower(u.Scheme) {
+	case "about", "data":
+		// Used by SetHtml and for blank pages.
+		return
+	}
+	if originOf(uri) == w.allowedOrigin {
+		return
+	}
+	_ = args.PutCancel(true)
+	if w.openExternal && (u.Scheme == "http" || u.Scheme == "https") {
+		openExternal(uri)
+	}
+}
+
+// originOf returns the scheme and host of uri in lower case, e.g.
+// "https://example.com:8443".
+func originOf(uri string) string {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return uri
+	}
+	return strings.ToLower(u.Scheme + "://" + u.Host)
+}
+
+// openExternal opens uri in the default browser of the user.
+func openExternal(uri string) {
+	verb, _ := windows.UTF16PtrFromString("open")
+	file, err := windows.UTF16PtrFromString(uri)
+	if err != nil {
+		return
+	}
+	win.ShellExecute(0, verb, file, nil, nil, win.SW_SHOWNORMAL)
+}
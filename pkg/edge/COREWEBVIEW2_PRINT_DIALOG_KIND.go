@@ -0,0 +1,8 @@
+package edge
+
+type COREWEBVIEW2_PRINT_DIALOG_KIND uint32
+
+const (
+	COREWEBVIEW2_PRINT_DIALOG_KIND_BROWSER = 0
+	COREWEBVIEW2_PRINT_DIALOG_KIND_SYSTEM  = 1
+)
@@ -0,0 +1,8 @@
+package edge
+
+type COREWEBVIEW2_PRINT_ORIENTATION uint32
+
+const (
+	COREWEBVIEW2_PRINT_ORIENTATION_PORTRAIT  = 0
+	COREWEBVIEW2_PRINT_ORIENTATION_LANDSCAPE = 1
+)
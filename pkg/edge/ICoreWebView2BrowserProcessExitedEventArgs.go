@@ -0,0 +1,30 @@
+package edge
+
+import (
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type _ICoreWebView2BrowserProcessExitedEventArgsVtbl struct {
+	_IUnknownVtbl
+	GetBrowserProcessExitKind ComProc
+	GetBrowserProcessId       ComProc
+}
+
+type ICoreWebView2BrowserProcessExitedEventArgs struct {
+	vtbl *_ICoreWebView2BrowserProcessExitedEventArgsVtbl
+}
+
+func (i *ICoreWebView2BrowserProcessExitedEventArgs) GetBrowserProcessId() (uint32, error) {
+	var err error
+	var pid uint32
+	_, _, err = i.vtbl.GetBrowserProcessId.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&pid)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return 0, err
+	}
+	return pid, nil
+}
@@ -0,0 +1,48 @@
+package edge
+
+type _ICoreWebView2CallDevToolsProtocolMethodCompletedHandlerVtbl struct {
+	_IUnknownVtbl
+	Invoke ComProc
+}
+
+type ICoreWebView2CallDevToolsProtocolMethodCompletedHandler struct {
+	vtbl *_ICoreWebView2CallDevToolsProtocolMethodCompletedHandlerVtbl
+	impl _ICoreWebView2CallDevToolsProtocolMethodCompletedHandlerImpl
+}
+
+func _ICoreWebView2CallDevToolsProtocolMethodCompletedHandlerIUnknownQueryInterface(this *ICoreWebView2CallDevToolsProtocolMethodCompletedHandler, refiid, object uintptr) uintptr {
+	return this.impl.QueryInterface(refiid, object)
+}
+
+func _ICoreWebView2CallDevToolsProtocolMethodCompletedHandlerIUnknownAddRef(this *ICoreWebView2CallDevToolsProtocolMethodCompletedHandler) uintptr {
+	return this.impl.AddRef()
+}
+
+func _ICoreWebView2CallDevToolsProtocolMethodCompletedHandlerIUnknownRelease(this *ICoreWebView2CallDevToolsProtocolMethodCompletedHandler) uintptr {
+	return this.impl.Release()
+}
+
+func _ICoreWebView2CallDevToolsProtocolMethodCompletedHandlerInvoke(this *ICoreWebView2CallDevToolsProtocolMethodCompletedHandler, errorCode uintptr, returnObjectAsJson *uint16) uintptr {
+	return this.impl.CallDevToolsProtocolMethodCompleted(errorCode, returnObjectAsJson)
+}
+
+type _ICoreWebView2CallDevToolsProtocolMethodCompletedHandlerImpl interface {
+	_IUnknownImpl
+	CallDevToolsProtocolMethodCompleted(errorCode uintptr, returnObjectAsJson *uint16) uintptr
+}
+
+var _ICoreWebView2CallDevToolsProtocolMethodCompletedHandlerFn = _ICoreWebView2CallDevToolsProtocolMethodCompletedHandlerVtbl{
+	_IUnknownVtbl{
+		NewComProc(_ICoreWebView2CallDevToolsProtocolMethodCompletedHandlerIUnknownQueryInterface),
+		NewComProc(_ICoreWebView2CallDevToolsProtocolMethodCompletedHandlerIUnknownAddRef),
+		NewComProc(_ICoreWebView2CallDevToolsProtocolMethodCompletedHandlerIUnknownRelease),
+	},
+	NewComProc(_ICoreWebView2CallDevToolsProtocolMethodCompletedHandlerInvoke),
+}
+
+func newICoreWebView2CallDevToolsProtocolMethodCompletedHandler(impl _ICoreWebView2CallDevToolsProtocolMethodCompletedHandlerImpl) *ICoreWebView2CallDevToolsProtocolMethodCompletedHandler {
+	return &ICoreWebView2CallDevToolsProtocolMethodCompletedHandler{
+		vtbl: &_ICoreWebView2CallDevToolsProtocolMethodCompletedHandlerFn,
+		impl: impl,
+	}
+}
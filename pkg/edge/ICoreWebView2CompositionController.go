@@ -0,0 +1,88 @@
+package edge
+
+import (
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type _ICoreWebView2CompositionControllerVtbl struct {
+	_IUnknownVtbl
+	GetRootVisualTarget ComProc
+	PutRootVisualTarget ComProc
+	SendMouseInput      ComProc
+	SendPointerInput    ComProc
+	GetCursor           ComProc
+	GetSystemCursorId   ComProc
+	AddCursorChanged    ComProc
+	RemoveCursorChanged ComProc
+}
+
+// ICoreWebView2CompositionController hosts the webview as a visual in a
+// DirectComposition or Windows.UI.Composition tree instead of a child window.
+// The host is responsible for forwarding mouse and pointer input.
+type ICoreWebView2CompositionController struct {
+	vtbl *_ICoreWebView2CompositionControllerVtbl
+}
+
+func (i *ICoreWebView2CompositionController) AddRef() uintptr {
+	r, _, _ := i.vtbl.AddRef.Call(uintptr(unsafe.Pointer(i)))
+	return r
+}
+
+func (i *ICoreWebView2CompositionController) Release() uintptr {
+	r, _, _ := i.vtbl.Release.Call(uintptr(unsafe.Pointer(i)))
+	return r
+}
+
+// GetRootVisualTarget returns the IUnknown of the visual the webview is
+// attached to, or 0. The caller must release it.
+func (i *ICoreWebView2CompositionController) GetRootVisualTarget() (uintptr, error) {
+	var err error
+	var target uintptr
+	_, _, err = i.vtbl.GetRootVisualTarget.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&target)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return 0, err
+	}
+	return target, nil
+}
+
+// PutRootVisualTarget attaches the webview to target, an IDCompositionVisual
+// or a Windows.UI.Composition ContainerVisual. A target of 0 detaches it.
+func (i *ICoreWebView2CompositionController) PutRootVisualTarget(target uintptr) error {
+	var err error
+	_, _, err = i.vtbl.PutRootVisualTarget.Call(
+		uintptr(unsafe.Pointer(i)),
+		target,
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
+
+func (i *ICoreWebView2CompositionController) GetSystemCursorId() (uint32, error) {
+	var err error
+	var id uint32
+	_, _, err = i.vtbl.GetSystemCursorId.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&id)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return 0, err
+	}
+	return id, nil
+}
+
+// GetICoreWebView2Controller returns the controller interface of the
+// composition controller, which handles bounds, visibility and focus.
+const iidICoreWebView2Controller = "{4d00c0d1-9434-4eb6-8078-8697a560334f}"
+
+func (i *ICoreWebView2CompositionController) GetICoreWebView2Controller() *ICoreWebView2Controller {
+	var result *ICoreWebView2Controller
+	_ = queryInterface(unsafe.Pointer(i), "ICoreWebView2Controller", iidICoreWebView2Controller, unsafe.Pointer(&result))
+	return result
+}
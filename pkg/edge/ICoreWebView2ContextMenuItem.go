@@ -0,0 +1,90 @@
+package edge
+
+import (
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type _ICoreWebView2ContextMenuItemVtbl struct {
+	_IUnknownVtbl
+	GetName                   ComProc
+	GetLabel                  ComProc
+	GetCommandId              ComProc
+	GetShortcutKeyDescription ComProc
+	GetIcon                   ComProc
+	GetKind                   ComProc
+	PutIsEnabled              ComProc
+	PutIsChecked              ComProc
+	GetIsEnabled              ComProc
+	GetIsChecked              ComProc
+	GetChildren               ComProc
+	AddCustomItemSelected     ComProc
+	RemoveCustomItemSelected  ComProc
+}
+
+type ICoreWebView2ContextMenuItem struct {
+	vtbl *_ICoreWebView2ContextMenuItemVtbl
+}
+
+func (i *ICoreWebView2ContextMenuItem) AddRef() uintptr {
+	r, _, _ := i.vtbl.AddRef.Call(uintptr(unsafe.Pointer(i)))
+	return r
+}
+
+func (i *ICoreWebView2ContextMenuItem) Release() uintptr {
+	r, _, _ := i.vtbl.Release.Call(uintptr(unsafe.Pointer(i)))
+	return r
+}
+
+// GetName returns the language independent name of the item, e.g. "saveAs"
+// or "print" for the default items.
+func (i *ICoreWebView2ContextMenuItem) GetName() (string, error) {
+	return i.getString(i.vtbl.GetName)
+}
+
+func (i *ICoreWebView2ContextMenuItem) GetLabel() (string, error) {
+	return i.getString(i.vtbl.GetLabel)
+}
+
+func (i *ICoreWebView2ContextMenuItem) GetCommandId() (int32, error) {
+	var err error
+	var commandId int32
+	_, _, err = i.vtbl.GetCommandId.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&commandId)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return 0, err
+	}
+	return commandId, nil
+}
+
+// GetChildren returns the items of a submenu. It fails for other kinds.
+func (i *ICoreWebView2ContextMenuItem) GetChildren() (*ICoreWebView2ContextMenuItemCollection, error) {
+	var err error
+	var children *ICoreWebView2ContextMenuItemCollection
+	_, _, err = i.vtbl.GetChildren.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&children)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return nil, err
+	}
+	return children, nil
+}
+
+func (i *ICoreWebView2ContextMenuItem) getString(proc ComProc) (string, error) {
+	var err error
+	var _value *uint16
+	_, _, err = proc.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&_value)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return "", err
+	}
+	value := windows.UTF16PtrToString(_value)
+	windows.CoTaskMemFree(unsafe.Pointer(_value))
+	return value, nil
+}
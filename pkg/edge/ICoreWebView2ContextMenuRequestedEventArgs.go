@@ -0,0 +1,58 @@
+package edge
+
+import (
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type _ICoreWebView2ContextMenuRequestedEventArgsVtbl struct {
+	_IUnknownVtbl
+	GetMenuItems         ComProc
+	GetContextMenuTarget ComProc
+	GetLocation          ComProc
+	PutSelectedCommandId ComProc
+	GetSelectedCommandId ComProc
+	PutHandled           ComProc
+	GetHandled           ComProc
+	GetDeferral          ComProc
+}
+
+type ICoreWebView2ContextMenuRequestedEventArgs struct {
+	vtbl *_ICoreWebView2ContextMenuRequestedEventArgsVtbl
+}
+
+func (i *ICoreWebView2ContextMenuRequestedEventArgs) AddRef() uintptr {
+	r, _, _ := i.vtbl.AddRef.Call(uintptr(unsafe.Pointer(i)))
+	return r
+}
+
+func (i *ICoreWebView2ContextMenuRequestedEventArgs) Release() uintptr {
+	r, _, _ := i.vtbl.Release.Call(uintptr(unsafe.Pointer(i)))
+	return r
+}
+
+func (i *ICoreWebView2ContextMenuRequestedEventArgs) GetMenuItems() (*ICoreWebView2ContextMenuItemCollection, error) {
+	var err error
+	var items *ICoreWebView2ContextMenuItemCollection
+	_, _, err = i.vtbl.GetMenuItems.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&items)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return nil, err
+	}
+	return items, nil
+}
+
+func (i *ICoreWebView2ContextMenuRequestedEventArgs) PutHandled(handled bool) error {
+	var err error
+	_, _, err = i.vtbl.PutHandled.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(boolToInt(handled)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
@@ -43,6 +43,11 @@ func (i *ICoreWebView2Controller) AddRef() uintptr {
 	return r
 }
 
+func (i *ICoreWebView2Controller) Release() uintptr {
+	r, _, _ := i.vtbl.Release.Call(uintptr(unsafe.Pointer(i)))
+	return r
+}
+
 func (i *ICoreWebView2Controller) GetBounds() (*w32.Rect, error) {
 	var err error
 	var bounds w32.Rect
@@ -69,6 +74,17 @@ func (i *ICoreWebView2Controller) PutBounds(bounds w32.Rect) error {
 	return nil
 }
 
+func (i *ICoreWebView2Controller) Close() error {
+	var err error
+	_, _, err = i.vtbl.Close.Call(
+		uintptr(unsafe.Pointer(i)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
+
 func (i *ICoreWebView2Controller) AddAcceleratorKeyPressed(eventHandler *ICoreWebView2AcceleratorKeyPressedEventHandler, token *_EventRegistrationToken) error {
 	var err error
 	_, _, err = i.vtbl.AddAcceleratorKeyPressed.Call(
@@ -95,16 +111,11 @@ func (i *ICoreWebView2Controller) PutIsVisible(isVisible bool) error {
 	return nil
 }
 
-func (i *ICoreWebView2Controller) GetICoreWebView2Controller2() *ICoreWebView2Controller2 {
+const iidICoreWebView2Controller2 = "{c979903e-d4ca-4228-92eb-47ee3fa96eab}"
 
+func (i *ICoreWebView2Controller) GetICoreWebView2Controller2() *ICoreWebView2Controller2 {
 	var result *ICoreWebView2Controller2
-
-	iidICoreWebView2Controller2 := NewGUID("{c979903e-d4ca-4228-92eb-47ee3fa96eab}")
-	_, _, _ = i.vtbl.QueryInterface.Call(
-		uintptr(unsafe.Pointer(i)),
-		uintptr(unsafe.Pointer(iidICoreWebView2Controller2)),
-		uintptr(unsafe.Pointer(&result)))
-
+	_ = queryInterface(unsafe.Pointer(i), "ICoreWebView2Controller2", iidICoreWebView2Controller2, unsafe.Pointer(&result))
 	return result
 }
 
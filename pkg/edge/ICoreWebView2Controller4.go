@@ -0,0 +1,67 @@
+package edge
+
+import (
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type _ICoreWebView2Controller3Vtbl struct {
+	_ICoreWebView2Controller2Vtbl
+	GetRasterizationScale              ComProc
+	PutRasterizationScale              ComProc
+	GetShouldDetectMonitorScaleChanges ComProc
+	PutShouldDetectMonitorScaleChanges ComProc
+	AddRasterizationScaleChanged       ComProc
+	RemoveRasterizationScaleChanged    ComProc
+	GetBoundsMode                      ComProc
+	PutBoundsMode                      ComProc
+}
+
+type _ICoreWebView2Controller4Vtbl struct {
+	_ICoreWebView2Controller3Vtbl
+	GetAllowExternalDrop ComProc
+	PutAllowExternalDrop ComProc
+}
+
+type ICoreWebView2Controller4 struct {
+	vtbl *_ICoreWebView2Controller4Vtbl
+}
+
+func (i *ICoreWebView2Controller4) Release() uintptr {
+	r, _, _ := i.vtbl.Release.Call(uintptr(unsafe.Pointer(i)))
+	return r
+}
+
+func (i *ICoreWebView2Controller4) GetAllowExternalDrop() (bool, error) {
+	var err error
+	var value int32
+	_, _, err = i.vtbl.GetAllowExternalDrop.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&value)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return false, err
+	}
+	return value != 0, nil
+}
+
+func (i *ICoreWebView2Controller4) PutAllowExternalDrop(value bool) error {
+	var err error
+	_, _, err = i.vtbl.PutAllowExternalDrop.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(boolToInt(value)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
+
+const iidICoreWebView2Controller4 = "{97d418d5-a426-4e49-a151-e1a10f327d9e}"
+
+func (i *ICoreWebView2Controller) GetICoreWebView2Controller4() *ICoreWebView2Controller4 {
+	var result *ICoreWebView2Controller4
+	_ = queryInterface(unsafe.Pointer(i), "ICoreWebView2Controller4", iidICoreWebView2Controller4, unsafe.Pointer(&result))
+	return result
+}
@@ -0,0 +1,171 @@
+package edge
+
+import (
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type _ICoreWebView2CookieVtbl struct {
+	_IUnknownVtbl
+	GetName       ComProc
+	GetValue      ComProc
+	PutValue      ComProc
+	GetDomain     ComProc
+	GetPath       ComProc
+	GetExpires    ComProc
+	PutExpires    ComProc
+	GetIsHttpOnly ComProc
+	PutIsHttpOnly ComProc
+	GetSameSite   ComProc
+	PutSameSite   ComProc
+	GetIsSecure   ComProc
+	PutIsSecure   ComProc
+	GetIsSession  ComProc
+}
+
+type ICoreWebView2Cookie struct {
+	vtbl *_ICoreWebView2CookieVtbl
+}
+
+func (i *ICoreWebView2Cookie) AddRef() uintptr {
+	r, _, _ := i.vtbl.AddRef.Call(uintptr(unsafe.Pointer(i)))
+	return r
+}
+
+func (i *ICoreWebView2Cookie) Release() uintptr {
+	r, _, _ := i.vtbl.Release.Call(uintptr(unsafe.Pointer(i)))
+	return r
+}
+
+func (i *ICoreWebView2Cookie) getString(proc ComProc) (string, error) {
+	var err error
+	// Create *uint16 to hold result
+	var _value *uint16
+	_, _, err = proc.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&_value)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return "", err
+	} // Get result and cleanup
+	value := windows.UTF16PtrToString(_value)
+	windows.CoTaskMemFree(unsafe.Pointer(_value))
+	return value, nil
+}
+
+func (i *ICoreWebView2Cookie) getBool(proc ComProc) (bool, error) {
+	var err error
+	var value int32
+	_, _, err = proc.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&value)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return false, err
+	}
+	return value != 0, nil
+}
+
+func (i *ICoreWebView2Cookie) putBool(proc ComProc, value bool) error {
+	var err error
+	_, _, err = proc.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(boolToInt(value)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
+
+func (i *ICoreWebView2Cookie) GetName() (string, error) {
+	return i.getString(i.vtbl.GetName)
+}
+
+func (i *ICoreWebView2Cookie) GetValue() (string, error) {
+	return i.getString(i.vtbl.GetValue)
+}
+
+func (i *ICoreWebView2Cookie) PutValue(value string) error {
+	_value, err := windows.UTF16PtrFromString(value)
+	if err != nil {
+		return err
+	}
+	_, _, err = i.vtbl.PutValue.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(_value)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
+
+func (i *ICoreWebView2Cookie) GetDomain() (string, error) {
+	return i.getString(i.vtbl.GetDomain)
+}
+
+func (i *ICoreWebView2Cookie) GetPath() (string, error) {
+	return i.getString(i.vtbl.GetPath)
+}
+
+// GetExpires returns the expiration date in seconds since the UNIX epoch.
+// Session cookies report -1.
+func (i *ICoreWebView2Cookie) GetExpires() (float64, error) {
+	var err error
+	var expires float64
+	_, _, err = i.vtbl.GetExpires.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&expires)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return 0, err
+	}
+	return expires, nil
+}
+
+func (i *ICoreWebView2Cookie) GetIsHttpOnly() (bool, error) {
+	return i.getBool(i.vtbl.GetIsHttpOnly)
+}
+
+func (i *ICoreWebView2Cookie) PutIsHttpOnly(isHttpOnly bool) error {
+	return i.putBool(i.vtbl.PutIsHttpOnly, isHttpOnly)
+}
+
+func (i *ICoreWebView2Cookie) GetSameSite() (COREWEBVIEW2_COOKIE_SAME_SITE_KIND, error) {
+	var err error
+	var sameSite COREWEBVIEW2_COOKIE_SAME_SITE_KIND
+	_, _, err = i.vtbl.GetSameSite.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&sameSite)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return 0, err
+	}
+	return sameSite, nil
+}
+
+func (i *ICoreWebView2Cookie) PutSameSite(sameSite COREWEBVIEW2_COOKIE_SAME_SITE_KIND) error {
+	var err error
+	_, _, err = i.vtbl.PutSameSite.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(sameSite),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
+
+func (i *ICoreWebView2Cookie) GetIsSecure() (bool, error) {
+	return i.getBool(i.vtbl.GetIsSecure)
+}
+
+func (i *ICoreWebView2Cookie) PutIsSecure(isSecure bool) error {
+	return i.putBool(i.vtbl.PutIsSecure, isSecure)
+}
+
+func (i *ICoreWebView2Cookie) GetIsSession() (bool, error) {
+	return i.getBool(i.vtbl.GetIsSession)
+}
@@ -0,0 +1,208 @@
+package edge
+
+import (
+	"errors"
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type _ICoreWebView2CookieManagerVtbl struct {
+	_IUnknownVtbl
+	CreateCookie                   ComProc
+	CopyCookie                     ComProc
+	GetCookies                     ComProc
+	AddOrUpdateCookie              ComProc
+	DeleteCookie                   ComProc
+	DeleteCookies                  ComProc
+	DeleteCookiesWithDomainAndPath ComProc
+	DeleteAllCookies               ComProc
+}
+
+type ICoreWebView2CookieManager struct {
+	vtbl *_ICoreWebView2CookieManagerVtbl
+}
+
+func (i *ICoreWebView2CookieManager) AddRef() uintptr {
+	r, _, _ := i.vtbl.AddRef.Call(uintptr(unsafe.Pointer(i)))
+	return r
+}
+
+func (i *ICoreWebView2CookieManager) Release() uintptr {
+	r, _, _ := i.vtbl.Release.Call(uintptr(unsafe.Pointer(i)))
+	return r
+}
+
+func (i *ICoreWebView2CookieManager) CreateCookie(name, value, domain, path string) (*ICoreWebView2Cookie, error) {
+	_name, err := windows.UTF16PtrFromString(name)
+	if err != nil {
+		return nil, err
+	}
+	_value, err := windows.UTF16PtrFromString(value)
+	if err != nil {
+		return nil, err
+	}
+	_domain, err := windows.UTF16PtrFromString(domain)
+	if err != nil {
+		return nil, err
+	}
+	_path, err := windows.UTF16PtrFromString(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var cookie *ICoreWebView2Cookie
+	_, _, err = i.vtbl.CreateCookie.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(_name)),
+		uintptr(unsafe.Pointer(_value)),
+		uintptr(unsafe.Pointer(_domain)),
+		uintptr(unsafe.Pointer(_path)),
+		uintptr(unsafe.Pointer(&cookie)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return nil, err
+	}
+	if cookie == nil {
+		return nil, errors.New("CreateCookie returned no cookie")
+	}
+	return cookie, nil
+}
+
+// GetCookies gets the cookies matching uri, or all cookies if uri is empty,
+// and passes them to handler once done.
+func (i *ICoreWebView2CookieManager) GetCookies(uri string, handler *ICoreWebView2GetCookiesCompletedHandler) error {
+	_uri, err := windows.UTF16PtrFromString(uri)
+	if err != nil {
+		return err
+	}
+	_, _, err = i.vtbl.GetCookies.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(_uri)),
+		uintptr(unsafe.Pointer(handler)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
+
+func (i *ICoreWebView2CookieManager) AddOrUpdateCookie(cookie *ICoreWebView2Cookie) error {
+	var err error
+	_, _, err = i.vtbl.AddOrUpdateCookie.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(cookie)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
+
+func (i *ICoreWebView2CookieManager) DeleteCookie(cookie *ICoreWebView2Cookie) error {
+	var err error
+	_, _, err = i.vtbl.DeleteCookie.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(cookie)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
+
+func (i *ICoreWebView2CookieManager) DeleteCookies(name, uri string) error {
+	_name, err := windows.UTF16PtrFromString(name)
+	if err != nil {
+		return err
+	}
+	_uri, err := windows.UTF16PtrFromString(uri)
+	if err != nil {
+		return err
+	}
+	_, _, err = i.vtbl.DeleteCookies.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(_name)),
+		uintptr(unsafe.Pointer(_uri)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
+
+func (i *ICoreWebView2CookieManager) DeleteAllCookies() error {
+	var err error
+	_, _, err = i.vtbl.DeleteAllCookies.Call(
+		uintptr(unsafe.Pointer(i)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
+
+func (e *Chromium) GetCookieManager() (*ICoreWebView2CookieManager, error) {
+	var webview2 *ICoreWebView2_2
+	if err := e.QueryWebView("ICoreWebView2_2", iidICoreWebView2_2, unsafe.Pointer(&webview2)); err != nil {
+		return nil, err
+	}
+	defer webview2.Release()
+	return webview2.GetCookieManager()
+}
+
+// GetCookies gets the cookies matching uri and calls callback with them once
+// done. The list is only valid during the call.
+func (e *Chromium) GetCookies(uri string, callback func(list *ICoreWebView2CookieList, err error)) error {
+	manager, err := e.GetCookieManager()
+	if err != nil {
+		return err
+	}
+	defer manager.Release()
+
+	// Kept reachable until the completion fires, like pendingScripts.
+	pending := &cookiesCompleted{chromium: e, callback: callback}
+	pending.handler = newICoreWebView2GetCookiesCompletedHandler(pending)
+	if e.pendingCookies == nil {
+		e.pendingCookies = make(map[*cookiesCompleted]struct{})
+	}
+	e.pendingCookies[pending] = struct{}{}
+
+	if err := manager.GetCookies(uri, pending.handler); err != nil {
+		delete(e.pendingCookies, pending)
+		return err
+	}
+	return nil
+}
+
+type cookiesCompleted struct {
+	chromium *Chromium
+	handler  *ICoreWebView2GetCookiesCompletedHandler
+	callback func(list *ICoreWebView2CookieList, err error)
+}
+
+func (c *cookiesCompleted) QueryInterface(_, _ uintptr) uintptr {
+	return 0
+}
+
+func (c *cookiesCompleted) AddRef() uintptr {
+	return 1
+}
+
+func (c *cookiesCompleted) Release() uintptr {
+	return 1
+}
+
+func (c *cookiesCompleted) GetCookiesCompleted(errorCode uintptr, cookieList *ICoreWebView2CookieList) uintptr {
+	delete(c.chromium.pendingCookies, c)
+	if c.callback == nil {
+		return 0
+	}
+	if int32(errorCode) < 0 {
+		c.callback(nil, syscall.Errno(errorCode))
+		return 0
+	}
+	c.callback(cookieList, nil)
+	return 0
+}
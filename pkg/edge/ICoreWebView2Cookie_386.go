@@ -0,0 +1,24 @@
+package edge
+
+import (
+	"math"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+// PutExpires sets the expiration date in seconds since the UNIX epoch.
+func (i *ICoreWebView2Cookie) PutExpires(expires float64) error {
+	var err error
+	// stdcall passes the double on the stack as two 32-bit words.
+	bits := math.Float64bits(expires)
+	_, _, err = i.vtbl.PutExpires.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(uint32(bits)),
+		uintptr(uint32(bits>>32)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,23 @@
+package edge
+
+import (
+	"math"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+// PutExpires sets the expiration date in seconds since the UNIX epoch.
+func (i *ICoreWebView2Cookie) PutExpires(expires float64) error {
+	var err error
+	// The syscall trampoline mirrors the first four integer arguments into the
+	// XMM registers, so the double can be passed as its bit pattern.
+	_, _, err = i.vtbl.PutExpires.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(math.Float64bits(expires)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
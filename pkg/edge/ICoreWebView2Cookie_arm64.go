@@ -0,0 +1,11 @@
+package edge
+
+import "errors"
+
+// PutExpires sets the expiration date in seconds since the UNIX epoch.
+//
+// On arm64 the double would have to be passed in a floating point register,
+// which the syscall package can't do, so this always returns an error.
+func (i *ICoreWebView2Cookie) PutExpires(expires float64) error {
+	return errors.New("PutExpires is not supported on arm64")
+}
@@ -0,0 +1,48 @@
+package edge
+
+type _ICoreWebView2CreateCoreWebView2CompositionControllerCompletedHandlerVtbl struct {
+	_IUnknownVtbl
+	Invoke ComProc
+}
+
+type iCoreWebView2CreateCoreWebView2CompositionControllerCompletedHandler struct {
+	vtbl *_ICoreWebView2CreateCoreWebView2CompositionControllerCompletedHandlerVtbl
+	impl _ICoreWebView2CreateCoreWebView2CompositionControllerCompletedHandlerImpl
+}
+
+func _ICoreWebView2CreateCoreWebView2CompositionControllerCompletedHandlerIUnknownQueryInterface(this *iCoreWebView2CreateCoreWebView2CompositionControllerCompletedHandler, refiid, object uintptr) uintptr {
+	return this.impl.QueryInterface(refiid, object)
+}
+
+func _ICoreWebView2CreateCoreWebView2CompositionControllerCompletedHandlerIUnknownAddRef(this *iCoreWebView2CreateCoreWebView2CompositionControllerCompletedHandler) uintptr {
+	return this.impl.AddRef()
+}
+
+func _ICoreWebView2CreateCoreWebView2CompositionControllerCompletedHandlerIUnknownRelease(this *iCoreWebView2CreateCoreWebView2CompositionControllerCompletedHandler) uintptr {
+	return this.impl.Release()
+}
+
+func _ICoreWebView2CreateCoreWebView2CompositionControllerCompletedHandlerInvoke(this *iCoreWebView2CreateCoreWebView2CompositionControllerCompletedHandler, errorCode uintptr, createdController *ICoreWebView2CompositionController) uintptr {
+	return this.impl.CreateCoreWebView2CompositionControllerCompleted(errorCode, createdController)
+}
+
+type _ICoreWebView2CreateCoreWebView2CompositionControllerCompletedHandlerImpl interface {
+	_IUnknownImpl
+	CreateCoreWebView2CompositionControllerCompleted(errorCode uintptr, createdController *ICoreWebView2CompositionController) uintptr
+}
+
+var _ICoreWebView2CreateCoreWebView2CompositionControllerCompletedHandlerFn = _ICoreWebView2CreateCoreWebView2CompositionControllerCompletedHandlerVtbl{
+	_IUnknownVtbl{
+		NewComProc(_ICoreWebView2CreateCoreWebView2CompositionControllerCompletedHandlerIUnknownQueryInterface),
+		NewComProc(_ICoreWebView2CreateCoreWebView2CompositionControllerCompletedHandlerIUnknownAddRef),
+		NewComProc(_ICoreWebView2CreateCoreWebView2CompositionControllerCompletedHandlerIUnknownRelease),
+	},
+	NewComProc(_ICoreWebView2CreateCoreWebView2CompositionControllerCompletedHandlerInvoke),
+}
+
+func newICoreWebView2CreateCoreWebView2CompositionControllerCompletedHandler(impl _ICoreWebView2CreateCoreWebView2CompositionControllerCompletedHandlerImpl) *iCoreWebView2CreateCoreWebView2CompositionControllerCompletedHandler {
+	return &iCoreWebView2CreateCoreWebView2CompositionControllerCompletedHandler{
+		vtbl: &_ICoreWebView2CreateCoreWebView2CompositionControllerCompletedHandlerFn,
+		impl: impl,
+	}
+}
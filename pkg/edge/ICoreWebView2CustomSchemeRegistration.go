@@ -0,0 +1,123 @@
+package edge
+
+import (
+	"unsafe"
+
+	"github.com/mzky/go-webview2/internal/w32"
+)
+
+// ICoreWebView2CustomSchemeRegistration is implemented in Go and handed to the
+// runtime through ICoreWebView2EnvironmentOptions4 when the environment is
+// created.
+
+type _ICoreWebView2CustomSchemeRegistrationVtbl struct {
+	_IUnknownVtbl
+	GetSchemeName            ComProc
+	GetTreatAsSecure         ComProc
+	PutTreatAsSecure         ComProc
+	GetAllowedOrigins        ComProc
+	SetAllowedOrigins        ComProc
+	GetHasAuthorityComponent ComProc
+	PutHasAuthorityComponent ComProc
+}
+
+type ICoreWebView2CustomSchemeRegistration struct {
+	vtbl *_ICoreWebView2CustomSchemeRegistrationVtbl
+
+	// SchemeName is the name of the scheme without the colon, e.g. "myapp".
+	SchemeName string
+	// TreatAsSecure makes the scheme behave like https for secure contexts.
+	TreatAsSecure bool
+	// AllowedOrigins lists the origins allowed to issue requests to the
+	// scheme. Pages of the scheme itself are always allowed.
+	AllowedOrigins []string
+	// HasAuthorityComponent allows URIs of the form scheme://host/path.
+	HasAuthorityComponent bool
+}
+
+func _ICoreWebView2CustomSchemeRegistrationIUnknownQueryInterface(this *ICoreWebView2CustomSchemeRegistration, refiid *GUID, object *unsafe.Pointer) uintptr {
+	if IsEqualGUID(refiid, iidIUnknown) || IsEqualGUID(refiid, iidICoreWebView2CustomSchemeRegistration) {
+		*object = unsafe.Pointer(this)
+		return 0
+	}
+	*object = nil
+	return errNoInterface
+}
+
+func _ICoreWebView2CustomSchemeRegistrationIUnknownAddRef(this *ICoreWebView2CustomSchemeRegistration) uintptr {
+	return 1
+}
+
+func _ICoreWebView2CustomSchemeRegistrationIUnknownRelease(this *ICoreWebView2CustomSchemeRegistration) uintptr {
+	return 1
+}
+
+func _ICoreWebView2CustomSchemeRegistrationGetSchemeName(this *ICoreWebView2CustomSchemeRegistration, schemeName **uint16) uintptr {
+	*schemeName = w32.CoTaskMemString(this.SchemeName)
+	return 0
+}
+
+func _ICoreWebView2CustomSchemeRegistrationGetTreatAsSecure(this *ICoreWebView2CustomSchemeRegistration, treatAsSecure *int32) uintptr {
+	*treatAsSecure = int32(boolToInt(this.TreatAsSecure))
+	return 0
+}
+
+func _ICoreWebView2CustomSchemeRegistrationPutTreatAsSecure(this *ICoreWebView2CustomSchemeRegistration, treatAsSecure uintptr) uintptr {
+	this.TreatAsSecure = treatAsSecure != 0
+	return 0
+}
+
+func _ICoreWebView2CustomSchemeRegistrationGetAllowedOrigins(this *ICoreWebView2CustomSchemeRegistration, allowedOriginsCount *uint32, allowedOrigins ***uint16) uintptr {
+	*allowedOriginsCount = uint32(len(this.AllowedOrigins))
+	*allowedOrigins = nil
+	if len(this.AllowedOrigins) == 0 {
+		return 0
+	}
+	p := (**uint16)(w32.CoTaskMemAlloc(uintptr(len(this.AllowedOrigins)) * unsafe.Sizeof(uintptr(0))))
+	origins := unsafe.Slice(p, len(this.AllowedOrigins))
+	for i, origin := range this.AllowedOrigins {
+		origins[i] = w32.CoTaskMemString(origin)
+	}
+	*allowedOrigins = p
+	return 0
+}
+
+func _ICoreWebView2CustomSchemeRegistrationSetAllowedOrigins(this *ICoreWebView2CustomSchemeRegistration, allowedOriginsCount uintptr, allowedOrigins **uint16) uintptr {
+	this.AllowedOrigins = nil
+	for _, origin := range unsafe.Slice(allowedOrigins, allowedOriginsCount) {
+		this.AllowedOrigins = append(this.AllowedOrigins, w32.Utf16PtrToString(origin))
+	}
+	return 0
+}
+
+func _ICoreWebView2CustomSchemeRegistrationGetHasAuthorityComponent(this *ICoreWebView2CustomSchemeRegistration, hasAuthorityComponent *int32) uintptr {
+	*hasAuthorityComponent = int32(boolToInt(this.HasAuthorityComponent))
+	return 0
+}
+
+func _ICoreWebView2CustomSchemeRegistrationPutHasAuthorityComponent(this *ICoreWebView2CustomSchemeRegistration, hasAuthorityComponent uintptr) uintptr {
+	this.HasAuthorityComponent = hasAuthorityComponent != 0
+	return 0
+}
+
+var _ICoreWebView2CustomSchemeRegistrationFn = _ICoreWebView2CustomSchemeRegistrationVtbl{
+	_IUnknownVtbl{
+		NewComProc(_ICoreWebView2CustomSchemeRegistrationIUnknownQueryInterface),
+		NewComProc(_ICoreWebView2CustomSchemeRegistrationIUnknownAddRef),
+		NewComProc(_ICoreWebView2CustomSchemeRegistrationIUnknownRelease),
+	},
+	NewComProc(_ICoreWebView2CustomSchemeRegistrationGetSchemeName),
+	NewComProc(_ICoreWebView2CustomSchemeRegistrationGetTreatAsSecure),
+	NewComProc(_ICoreWebView2CustomSchemeRegistrationPutTreatAsSecure),
+	NewComProc(_ICoreWebView2CustomSchemeRegistrationGetAllowedOrigins),
+	NewComProc(_ICoreWebView2CustomSchemeRegistrationSetAllowedOrigins),
+	NewComProc(_ICoreWebView2CustomSchemeRegistrationGetHasAuthorityComponent),
+	NewComProc(_ICoreWebView2CustomSchemeRegistrationPutHasAuthorityComponent),
+}
+
+func NewICoreWebView2CustomSchemeRegistration(schemeName string) *ICoreWebView2CustomSchemeRegistration {
+	return &ICoreWebView2CustomSchemeRegistration{
+		vtbl:       &_ICoreWebView2CustomSchemeRegistrationFn,
+		SchemeName: schemeName,
+	}
+}
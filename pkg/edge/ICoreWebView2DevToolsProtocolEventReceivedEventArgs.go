@@ -0,0 +1,38 @@
+package edge
+
+import (
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type _ICoreWebView2DevToolsProtocolEventReceivedEventArgsVtbl struct {
+	_IUnknownVtbl
+	GetParameterObjectAsJson ComProc
+}
+
+type ICoreWebView2DevToolsProtocolEventReceivedEventArgs struct {
+	vtbl *_ICoreWebView2DevToolsProtocolEventReceivedEventArgsVtbl
+}
+
+func (i *ICoreWebView2DevToolsProtocolEventReceivedEventArgs) AddRef() uintptr {
+	r, _, _ := i.vtbl.AddRef.Call(uintptr(unsafe.Pointer(i)))
+	return r
+}
+
+func (i *ICoreWebView2DevToolsProtocolEventReceivedEventArgs) GetParameterObjectAsJson() (string, error) {
+	var err error
+	// Create *uint16 to hold result
+	var _params *uint16
+	_, _, err = i.vtbl.GetParameterObjectAsJson.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&_params)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return "", err
+	}
+	// Get result and cleanup
+	params := windows.UTF16PtrToString(_params)
+	windows.CoTaskMemFree(unsafe.Pointer(_params))
+	return params, nil
+}
@@ -0,0 +1,40 @@
+package edge
+
+import (
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type _ICoreWebView2DevToolsProtocolEventReceiverVtbl struct {
+	_IUnknownVtbl
+	AddDevToolsProtocolEventReceived    ComProc
+	RemoveDevToolsProtocolEventReceived ComProc
+}
+
+type ICoreWebView2DevToolsProtocolEventReceiver struct {
+	vtbl *_ICoreWebView2DevToolsProtocolEventReceiverVtbl
+}
+
+func (i *ICoreWebView2DevToolsProtocolEventReceiver) AddRef() uintptr {
+	r, _, _ := i.vtbl.AddRef.Call(uintptr(unsafe.Pointer(i)))
+	return r
+}
+
+func (i *ICoreWebView2DevToolsProtocolEventReceiver) Release() uintptr {
+	r, _, _ := i.vtbl.Release.Call(uintptr(unsafe.Pointer(i)))
+	return r
+}
+
+func (i *ICoreWebView2DevToolsProtocolEventReceiver) AddDevToolsProtocolEventReceived(eventHandler *ICoreWebView2DevToolsProtocolEventReceivedEventHandler, token *_EventRegistrationToken) error {
+	var err error
+	_, _, err = i.vtbl.AddDevToolsProtocolEventReceived.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(eventHandler)),
+		uintptr(unsafe.Pointer(token)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
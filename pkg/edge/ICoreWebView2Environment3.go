@@ -0,0 +1,37 @@
+package edge
+
+import (
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type ICoreWebView2Environment3 struct {
+	vtbl *iCoreWebView2Environment3Vtbl
+}
+
+func (i *ICoreWebView2Environment3) Release() uintptr {
+	r, _, _ := i.vtbl.Release.Call(uintptr(unsafe.Pointer(i)))
+	return r
+}
+
+func (i *ICoreWebView2Environment3) CreateCoreWebView2CompositionController(parentWindow uintptr, handler *iCoreWebView2CreateCoreWebView2CompositionControllerCompletedHandler) error {
+	var err error
+	_, _, err = i.vtbl.CreateCoreWebView2CompositionController.Call(
+		uintptr(unsafe.Pointer(i)),
+		parentWindow,
+		uintptr(unsafe.Pointer(handler)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
+
+const iidICoreWebView2Environment3 = "{80a22ae3-be7c-4ce2-afe1-5a50056cdeeb}"
+
+func (e *ICoreWebView2Environment) GetICoreWebView2Environment3() *ICoreWebView2Environment3 {
+	var result *ICoreWebView2Environment3
+	_ = queryInterface(unsafe.Pointer(e), "ICoreWebView2Environment3", iidICoreWebView2Environment3, unsafe.Pointer(&result))
+	return result
+}
@@ -0,0 +1,37 @@
+package edge
+
+import (
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type ICoreWebView2Environment5 struct {
+	vtbl *iCoreWebView2Environment5Vtbl
+}
+
+func (i *ICoreWebView2Environment5) Release() uintptr {
+	r, _, _ := i.vtbl.Release.Call(uintptr(unsafe.Pointer(i)))
+	return r
+}
+
+func (i *ICoreWebView2Environment5) AddBrowserProcessExited(eventHandler *ICoreWebView2BrowserProcessExitedEventHandler, token *_EventRegistrationToken) error {
+	var err error
+	_, _, err = i.vtbl.AddBrowserProcessExited.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(eventHandler)),
+		uintptr(unsafe.Pointer(token)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
+
+const iidICoreWebView2Environment5 = "{319E423D-E0D7-4B8D-9254-AE9475DE9B17}"
+
+func (e *ICoreWebView2Environment) GetICoreWebView2Environment5() *ICoreWebView2Environment5 {
+	var result *ICoreWebView2Environment5
+	_ = queryInterface(unsafe.Pointer(e), "ICoreWebView2Environment5", iidICoreWebView2Environment5, unsafe.Pointer(&result))
+	return result
+}
@@ -0,0 +1,37 @@
+package edge
+
+import (
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type ICoreWebView2Environment6 struct {
+	vtbl *iCoreWebView2Environment6Vtbl
+}
+
+func (i *ICoreWebView2Environment6) Release() uintptr {
+	r, _, _ := i.vtbl.Release.Call(uintptr(unsafe.Pointer(i)))
+	return r
+}
+
+func (i *ICoreWebView2Environment6) CreatePrintSettings() (*ICoreWebView2PrintSettings, error) {
+	var err error
+	var printSettings *ICoreWebView2PrintSettings
+	_, _, err = i.vtbl.CreatePrintSettings.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&printSettings)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return nil, err
+	}
+	return printSettings, nil
+}
+
+const iidICoreWebView2Environment6 = "{e59ee362-acbd-4857-9a8e-d3644d9459a9}"
+
+func (e *ICoreWebView2Environment) GetICoreWebView2Environment6() *ICoreWebView2Environment6 {
+	var result *ICoreWebView2Environment6
+	_ = queryInterface(unsafe.Pointer(e), "ICoreWebView2Environment6", iidICoreWebView2Environment6, unsafe.Pointer(&result))
+	return result
+}
@@ -0,0 +1,72 @@
+package edge
+
+import (
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type iCoreWebView2Environment2Vtbl struct {
+	iCoreWebView2EnvironmentVtbl
+	CreateWebResourceRequest ComProc
+}
+
+type iCoreWebView2Environment3Vtbl struct {
+	iCoreWebView2Environment2Vtbl
+	CreateCoreWebView2CompositionController ComProc
+	CreateCoreWebView2PointerInfo           ComProc
+}
+
+type iCoreWebView2Environment4Vtbl struct {
+	iCoreWebView2Environment3Vtbl
+	GetAutomationProviderForWindow ComProc
+}
+
+type iCoreWebView2Environment5Vtbl struct {
+	iCoreWebView2Environment4Vtbl
+	AddBrowserProcessExited    ComProc
+	RemoveBrowserProcessExited ComProc
+}
+
+type iCoreWebView2Environment6Vtbl struct {
+	iCoreWebView2Environment5Vtbl
+	CreatePrintSettings ComProc
+}
+
+type iCoreWebView2Environment7Vtbl struct {
+	iCoreWebView2Environment6Vtbl
+	GetUserDataFolder ComProc
+}
+
+type ICoreWebView2Environment7 struct {
+	vtbl *iCoreWebView2Environment7Vtbl
+}
+
+func (i *ICoreWebView2Environment7) Release() uintptr {
+	r, _, _ := i.vtbl.Release.Call(uintptr(unsafe.Pointer(i)))
+	return r
+}
+
+func (i *ICoreWebView2Environment7) GetUserDataFolder() (string, error) {
+	var err error
+	// Create *uint16 to hold result
+	var _value *uint16
+	_, _, err = i.vtbl.GetUserDataFolder.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&_value)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return "", err
+	} // Get result and cleanup
+	value := windows.UTF16PtrToString(_value)
+	windows.CoTaskMemFree(unsafe.Pointer(_value))
+	return value, nil
+}
+
+const iidICoreWebView2Environment7 = "{43C22296-3BBD-43A4-9C00-5C0DF6DD29A2}"
+
+func (e *ICoreWebView2Environment) GetICoreWebView2Environment7() *ICoreWebView2Environment7 {
+	var result *ICoreWebView2Environment7
+	_ = queryInterface(unsafe.Pointer(e), "ICoreWebView2Environment7", iidICoreWebView2Environment7, unsafe.Pointer(&result))
+	return result
+}
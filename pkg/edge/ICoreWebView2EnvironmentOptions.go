@@ -0,0 +1,184 @@
+package edge
+
+import (
+	"unsafe"
+
+	"github.com/mzky/go-webview2/internal/w32"
+)
+
+// ICoreWebView2EnvironmentOptions is implemented in Go and passed to
+// CreateCoreWebView2EnvironmentWithOptions. Besides the base interface it
+// answers QueryInterface for ICoreWebView2EnvironmentOptions4, which carries
+// the custom scheme registrations.
+
+var (
+	iidICoreWebView2EnvironmentOptions       = NewGUID("{2fde08a8-1e9a-4766-8c05-95a9ceb9d1c5}")
+	iidICoreWebView2EnvironmentOptions4      = NewGUID("{AC52D13F-0D38-475A-9DCA-876580D6793E}")
+	iidICoreWebView2CustomSchemeRegistration = NewGUID("{d60ac92c-37a6-4b26-a39e-95cfe59047bb}")
+)
+
+type _ICoreWebView2EnvironmentOptionsVtbl struct {
+	_IUnknownVtbl
+	GetAdditionalBrowserArguments             ComProc
+	PutAdditionalBrowserArguments             ComProc
+	GetLanguage                               ComProc
+	PutLanguage                               ComProc
+	GetTargetCompatibleBrowserVersion         ComProc
+	PutTargetCompatibleBrowserVersion         ComProc
+	GetAllowSingleSignOnUsingOSPrimaryAccount ComProc
+	PutAllowSingleSignOnUsingOSPrimaryAccount ComProc
+}
+
+type _ICoreWebView2EnvironmentOptions4Vtbl struct {
+	_IUnknownVtbl
+	GetCustomSchemeRegistrations ComProc
+	SetCustomSchemeRegistrations ComProc
+}
+
+type iCoreWebView2EnvironmentOptions4 struct {
+	vtbl    *_ICoreWebView2EnvironmentOptions4Vtbl
+	options *ICoreWebView2EnvironmentOptions
+}
+
+type ICoreWebView2EnvironmentOptions struct {
+	vtbl     *_ICoreWebView2EnvironmentOptionsVtbl
+	options4 iCoreWebView2EnvironmentOptions4
+
+	AdditionalBrowserArguments             string
+	Language                               string
+	TargetCompatibleBrowserVersion         string
+	AllowSingleSignOnUsingOSPrimaryAccount bool
+	CustomSchemeRegistrations              []*ICoreWebView2CustomSchemeRegistration
+}
+
+func _ICoreWebView2EnvironmentOptionsIUnknownQueryInterface(this *ICoreWebView2EnvironmentOptions, refiid *GUID, object *unsafe.Pointer) uintptr {
+	switch {
+	case IsEqualGUID(refiid, iidIUnknown), IsEqualGUID(refiid, iidICoreWebView2EnvironmentOptions):
+		*object = unsafe.Pointer(this)
+	case IsEqualGUID(refiid, iidICoreWebView2EnvironmentOptions4):
+		*object = unsafe.Pointer(&this.options4)
+	default:
+		*object = nil
+		return errNoInterface
+	}
+	return 0
+}
+
+func _ICoreWebView2EnvironmentOptionsIUnknownAddRef(this *ICoreWebView2EnvironmentOptions) uintptr {
+	return 1
+}
+
+func _ICoreWebView2EnvironmentOptionsIUnknownRelease(this *ICoreWebView2EnvironmentOptions) uintptr {
+	return 1
+}
+
+func _ICoreWebView2EnvironmentOptionsGetAdditionalBrowserArguments(this *ICoreWebView2EnvironmentOptions, value **uint16) uintptr {
+	*value = w32.CoTaskMemString(this.AdditionalBrowserArguments)
+	return 0
+}
+
+func _ICoreWebView2EnvironmentOptionsPutAdditionalBrowserArguments(this *ICoreWebView2EnvironmentOptions, value *uint16) uintptr {
+	this.AdditionalBrowserArguments = w32.Utf16PtrToString(value)
+	return 0
+}
+
+func _ICoreWebView2EnvironmentOptionsGetLanguage(this *ICoreWebView2EnvironmentOptions, value **uint16) uintptr {
+	*value = w32.CoTaskMemString(this.Language)
+	return 0
+}
+
+func _ICoreWebView2EnvironmentOptionsPutLanguage(this *ICoreWebView2EnvironmentOptions, value *uint16) uintptr {
+	this.Language = w32.Utf16PtrToString(value)
+	return 0
+}
+
+func _ICoreWebView2EnvironmentOptionsGetTargetCompatibleBrowserVersion(this *ICoreWebView2EnvironmentOptions, value **uint16) uintptr {
+	*value = w32.CoTaskMemString(this.TargetCompatibleBrowserVersion)
+	return 0
+}
+
+func _ICoreWebView2EnvironmentOptionsPutTargetCompatibleBrowserVersion(this *ICoreWebView2EnvironmentOptions, value *uint16) uintptr {
+	this.TargetCompatibleBrowserVersion = w32.Utf16PtrToString(value)
+	return 0
+}
+
+func _ICoreWebView2EnvironmentOptionsGetAllowSingleSignOnUsingOSPrimaryAccount(this *ICoreWebView2EnvironmentOptions, value *int32) uintptr {
+	*value = int32(boolToInt(this.AllowSingleSignOnUsingOSPrimaryAccount))
+	return 0
+}
+
+func _ICoreWebView2EnvironmentOptionsPutAllowSingleSignOnUsingOSPrimaryAccount(this *ICoreWebView2EnvironmentOptions, value uintptr) uintptr {
+	this.AllowSingleSignOnUsingOSPrimaryAccount = value != 0
+	return 0
+}
+
+var _ICoreWebView2EnvironmentOptionsFn = _ICoreWebView2EnvironmentOptionsVtbl{
+	_IUnknownVtbl{
+		NewComProc(_ICoreWebView2EnvironmentOptionsIUnknownQueryInterface),
+		NewComProc(_ICoreWebView2EnvironmentOptionsIUnknownAddRef),
+		NewComProc(_ICoreWebView2EnvironmentOptionsIUnknownRelease),
+	},
+	NewComProc(_ICoreWebView2EnvironmentOptionsGetAdditionalBrowserArguments),
+	NewComProc(_ICoreWebView2EnvironmentOptionsPutAdditionalBrowserArguments),
+	NewComProc(_ICoreWebView2EnvironmentOptionsGetLanguage),
+	NewComProc(_ICoreWebView2EnvironmentOptionsPutLanguage),
+	NewComProc(_ICoreWebView2EnvironmentOptionsGetTargetCompatibleBrowserVersion),
+	NewComProc(_ICoreWebView2EnvironmentOptionsPutTargetCompatibleBrowserVersion),
+	NewComProc(_ICoreWebView2EnvironmentOptionsGetAllowSingleSignOnUsingOSPrimaryAccount),
+	NewComProc(_ICoreWebView2EnvironmentOptionsPutAllowSingleSignOnUsingOSPrimaryAccount),
+}
+
+func _ICoreWebView2EnvironmentOptions4IUnknownQueryInterface(this *iCoreWebView2EnvironmentOptions4, refiid *GUID, object *unsafe.Pointer) uintptr {
+	return _ICoreWebView2EnvironmentOptionsIUnknownQueryInterface(this.options, refiid, object)
+}
+
+func _ICoreWebView2EnvironmentOptions4IUnknownAddRef(this *iCoreWebView2EnvironmentOptions4) uintptr {
+	return 1
+}
+
+func _ICoreWebView2EnvironmentOptions4IUnknownRelease(this *iCoreWebView2EnvironmentOptions4) uintptr {
+	return 1
+}
+
+func _ICoreWebView2EnvironmentOptions4GetCustomSchemeRegistrations(this *iCoreWebView2EnvironmentOptions4, count *uint32, schemeRegistrations ***ICoreWebView2CustomSchemeRegistration) uintptr {
+	registrations := this.options.CustomSchemeRegistrations
+	*count = uint32(len(registrations))
+	*schemeRegistrations = nil
+	if len(registrations) == 0 {
+		return 0
+	}
+	// The caller takes ownership of the array and releases every element.
+	p := (**ICoreWebView2CustomSchemeRegistration)(w32.CoTaskMemAlloc(uintptr(len(registrations)) * unsafe.Sizeof(uintptr(0))))
+	copy(unsafe.Slice(p, len(registrations)), registrations)
+	*schemeRegistrations = p
+	return 0
+}
+
+func _ICoreWebView2EnvironmentOptions4SetCustomSchemeRegistrations(this *iCoreWebView2EnvironmentOptions4, count uintptr, schemeRegistrations **ICoreWebView2CustomSchemeRegistration) uintptr {
+	this.options.CustomSchemeRegistrations = append([]*ICoreWebView2CustomSchemeRegistration{}, unsafe.Slice(schemeRegistrations, count)...)
+	return 0
+}
+
+var _ICoreWebView2EnvironmentOptions4Fn = _ICoreWebView2EnvironmentOptions4Vtbl{
+	_IUnknownVtbl{
+		NewComProc(_ICoreWebView2EnvironmentOptions4IUnknownQueryInterface),
+		NewComProc(_ICoreWebView2EnvironmentOptions4IUnknownAddRef),
+		NewComProc(_ICoreWebView2EnvironmentOptions4IUnknownRelease),
+	},
+	NewComProc(_ICoreWebView2EnvironmentOptions4GetCustomSchemeRegistrations),
+	NewComProc(_ICoreWebView2EnvironmentOptions4SetCustomSchemeRegistrations),
+}
+
+func NewICoreWebView2EnvironmentOptions() *ICoreWebView2EnvironmentOptions {
+	options := &ICoreWebView2EnvironmentOptions{
+		vtbl: &_ICoreWebView2EnvironmentOptionsFn,
+		// The oldest runtime with a stable release, so every installed
+		// runtime satisfies the requirement.
+		TargetCompatibleBrowserVersion: "86.0.616.0",
+	}
+	options.options4 = iCoreWebView2EnvironmentOptions4{
+		vtbl:    &_ICoreWebView2EnvironmentOptions4Fn,
+		options: options,
+	}
+	return options
+}
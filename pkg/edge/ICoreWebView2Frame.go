@@ -0,0 +1,111 @@
+package edge
+
+import (
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type _ICoreWebView2FrameVtbl struct {
+	_IUnknownVtbl
+	GetName                          ComProc
+	AddNameChanged                   ComProc
+	RemoveNameChanged                ComProc
+	AddHostObjectToScriptWithOrigins ComProc
+	RemoveHostObjectFromScript       ComProc
+	AddDestroyed                     ComProc
+	RemoveDestroyed                  ComProc
+	IsDestroyed                      ComProc
+}
+
+type _ICoreWebView2Frame2Vtbl struct {
+	_ICoreWebView2FrameVtbl
+	AddNavigationStarting     ComProc
+	RemoveNavigationStarting  ComProc
+	AddContentLoading         ComProc
+	RemoveContentLoading      ComProc
+	AddNavigationCompleted    ComProc
+	RemoveNavigationCompleted ComProc
+	AddDOMContentLoaded       ComProc
+	RemoveDOMContentLoaded    ComProc
+	ExecuteScript             ComProc
+	PostWebMessageAsJson      ComProc
+	PostWebMessageAsString    ComProc
+	AddWebMessageReceived     ComProc
+	RemoveWebMessageReceived  ComProc
+}
+
+type ICoreWebView2Frame struct {
+	vtbl *_ICoreWebView2FrameVtbl
+}
+
+func (i *ICoreWebView2Frame) AddRef() uintptr {
+	r, _, _ := i.vtbl.AddRef.Call(uintptr(unsafe.Pointer(i)))
+	return r
+}
+
+func (i *ICoreWebView2Frame) Release() uintptr {
+	r, _, _ := i.vtbl.Release.Call(uintptr(unsafe.Pointer(i)))
+	return r
+}
+
+func (i *ICoreWebView2Frame) GetName() (string, error) {
+	var err error
+	var _name *uint16
+	_, _, err = i.vtbl.GetName.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&_name)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return "", err
+	}
+	name := windows.UTF16PtrToString(_name)
+	windows.CoTaskMemFree(unsafe.Pointer(_name))
+	return name, nil
+}
+
+func (i *ICoreWebView2Frame) IsDestroyed() (bool, error) {
+	var err error
+	var destroyed int32
+	_, _, err = i.vtbl.IsDestroyed.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&destroyed)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return false, err
+	}
+	return destroyed != 0, nil
+}
+
+const iidICoreWebView2Frame2 = "{7a6a5834-d185-4dbf-b63f-4a9bc43107d4}"
+
+func (i *ICoreWebView2Frame) GetICoreWebView2Frame2() *ICoreWebView2Frame2 {
+	var result *ICoreWebView2Frame2
+	_ = queryInterface(unsafe.Pointer(i), "ICoreWebView2Frame2", iidICoreWebView2Frame2, unsafe.Pointer(&result))
+	return result
+}
+
+type ICoreWebView2Frame2 struct {
+	vtbl *_ICoreWebView2Frame2Vtbl
+}
+
+func (i *ICoreWebView2Frame2) Release() uintptr {
+	r, _, _ := i.vtbl.Release.Call(uintptr(unsafe.Pointer(i)))
+	return r
+}
+
+func (i *ICoreWebView2Frame2) ExecuteScript(javaScript string, handler *ICoreWebView2ExecuteScriptCompletedHandler) error {
+	_javaScript, err := windows.UTF16PtrFromString(javaScript)
+	if err != nil {
+		return err
+	}
+	_, _, err = i.vtbl.ExecuteScript.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(_javaScript)),
+		uintptr(unsafe.Pointer(handler)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
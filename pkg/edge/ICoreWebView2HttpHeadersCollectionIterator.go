@@ -0,0 +1,91 @@
+package edge
+
+import (
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type _ICoreWebView2HttpHeadersCollectionIteratorVtbl struct {
+	_IUnknownVtbl
+	GetCurrentHeader    ComProc
+	GetHasCurrentHeader ComProc
+	MoveNext            ComProc
+}
+
+type ICoreWebView2HttpHeadersCollectionIterator struct {
+	vtbl *_ICoreWebView2HttpHeadersCollectionIteratorVtbl
+}
+
+func (i *ICoreWebView2HttpHeadersCollectionIterator) Release() uintptr {
+	r, _, _ := i.vtbl.Release.Call(uintptr(unsafe.Pointer(i)))
+	return r
+}
+
+func (i *ICoreWebView2HttpHeadersCollectionIterator) GetCurrentHeader() (string, string, error) {
+	var err error
+	var _name, _value *uint16
+	_, _, err = i.vtbl.GetCurrentHeader.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&_name)),
+		uintptr(unsafe.Pointer(&_value)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return "", "", err
+	}
+	name := windows.UTF16PtrToString(_name)
+	windows.CoTaskMemFree(unsafe.Pointer(_name))
+	value := windows.UTF16PtrToString(_value)
+	windows.CoTaskMemFree(unsafe.Pointer(_value))
+	return name, value, nil
+}
+
+func (i *ICoreWebView2HttpHeadersCollectionIterator) GetHasCurrentHeader() (bool, error) {
+	var err error
+	var hasCurrent int32
+	_, _, err = i.vtbl.GetHasCurrentHeader.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&hasCurrent)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return false, err
+	}
+	return hasCurrent != 0, nil
+}
+
+func (i *ICoreWebView2HttpHeadersCollectionIterator) MoveNext() (bool, error) {
+	var err error
+	var hasNext int32
+	_, _, err = i.vtbl.MoveNext.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&hasNext)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return false, err
+	}
+	return hasNext != 0, nil
+}
+
+func (i *ICoreWebView2HttpHeadersCollectionIterator) collect() (map[string]string, error) {
+	headers := map[string]string{}
+	for {
+		hasCurrent, err := i.GetHasCurrentHeader()
+		if err != nil {
+			return nil, err
+		}
+		if !hasCurrent {
+			return headers, nil
+		}
+		name, value, err := i.GetCurrentHeader()
+		if err != nil {
+			return nil, err
+		}
+		if previous, ok := headers[name]; ok {
+			value = previous + ", " + value
+		}
+		headers[name] = value
+		if _, err := i.MoveNext(); err != nil {
+			return nil, err
+		}
+	}
+}
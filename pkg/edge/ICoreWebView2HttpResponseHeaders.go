@@ -0,0 +1,54 @@
+package edge
+
+import (
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type _ICoreWebView2HttpResponseHeadersVtbl struct {
+	_IUnknownVtbl
+	AppendHeader ComProc
+	Contains     ComProc
+	GetHeader    ComProc
+	GetHeaders   ComProc
+	GetIterator  ComProc
+}
+
+type ICoreWebView2HttpResponseHeaders struct {
+	vtbl *_ICoreWebView2HttpResponseHeadersVtbl
+}
+
+func (i *ICoreWebView2HttpResponseHeaders) AddRef() uintptr {
+	r, _, _ := i.vtbl.AddRef.Call(uintptr(unsafe.Pointer(i)))
+	return r
+}
+
+func (i *ICoreWebView2HttpResponseHeaders) Release() uintptr {
+	r, _, _ := i.vtbl.Release.Call(uintptr(unsafe.Pointer(i)))
+	return r
+}
+
+func (i *ICoreWebView2HttpResponseHeaders) GetIterator() (*ICoreWebView2HttpHeadersCollectionIterator, error) {
+	var err error
+	var iterator *ICoreWebView2HttpHeadersCollectionIterator
+	_, _, err = i.vtbl.GetIterator.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&iterator)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return nil, err
+	}
+	return iterator, nil
+}
+
+// All returns all headers. Values of headers that occur more than once are
+// joined with ", " as in HTTP/1.1.
+func (i *ICoreWebView2HttpResponseHeaders) All() (map[string]string, error) {
+	iterator, err := i.GetIterator()
+	if err != nil {
+		return nil, err
+	}
+	defer iterator.Release()
+	return iterator.collect()
+}
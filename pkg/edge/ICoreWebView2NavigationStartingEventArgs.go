@@ -0,0 +1,82 @@
+package edge
+
+import (
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type _ICoreWebView2NavigationStartingEventArgsVtbl struct {
+	_IUnknownVtbl
+	GetUri             ComProc
+	GetIsUserInitiated ComProc
+	GetIsRedirected    ComProc
+	GetRequestHeaders  ComProc
+	GetCancel          ComProc
+	PutCancel          ComProc
+	GetNavigationId    ComProc
+}
+
+type ICoreWebView2NavigationStartingEventArgs struct {
+	vtbl *_ICoreWebView2NavigationStartingEventArgsVtbl
+}
+
+func (i *ICoreWebView2NavigationStartingEventArgs) AddRef() uintptr {
+	r, _, _ := i.vtbl.AddRef.Call(uintptr(unsafe.Pointer(i)))
+	return r
+}
+
+func (i *ICoreWebView2NavigationStartingEventArgs) GetUri() (string, error) {
+	var err error
+	// Create *uint16 to hold result
+	var _uri *uint16
+	_, _, err = i.vtbl.GetUri.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&_uri)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return "", err
+	}
+	// Get result and cleanup
+	uri := windows.UTF16PtrToString(_uri)
+	windows.CoTaskMemFree(unsafe.Pointer(_uri))
+	return uri, nil
+}
+
+func (i *ICoreWebView2NavigationStartingEventArgs) GetIsUserInitiated() (bool, error) {
+	var err error
+	var isUserInitiated int32
+	_, _, err = i.vtbl.GetIsUserInitiated.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&isUserInitiated)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return false, err
+	}
+	return isUserInitiated != 0, nil
+}
+
+func (i *ICoreWebView2NavigationStartingEventArgs) PutCancel(cancel bool) error {
+	var err error
+	_, _, err = i.vtbl.PutCancel.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(boolToInt(cancel)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
+
+func (i *ICoreWebView2NavigationStartingEventArgs) GetNavigationId() (uint64, error) {
+	var err error
+	var navigationId uint64
+	_, _, err = i.vtbl.GetNavigationId.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&navigationId)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return 0, err
+	}
+	return navigationId, nil
+}
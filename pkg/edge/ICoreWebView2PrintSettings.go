@@ -0,0 +1,167 @@
+package edge
+
+import (
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type _ICoreWebView2PrintSettingsVtbl struct {
+	_IUnknownVtbl
+	GetOrientation                ComProc
+	PutOrientation                ComProc
+	GetScaleFactor                ComProc
+	PutScaleFactor                ComProc
+	GetPageWidth                  ComProc
+	PutPageWidth                  ComProc
+	GetPageHeight                 ComProc
+	PutPageHeight                 ComProc
+	GetMarginTop                  ComProc
+	PutMarginTop                  ComProc
+	GetMarginBottom               ComProc
+	PutMarginBottom               ComProc
+	GetMarginLeft                 ComProc
+	PutMarginLeft                 ComProc
+	GetMarginRight                ComProc
+	PutMarginRight                ComProc
+	GetShouldPrintBackgrounds     ComProc
+	PutShouldPrintBackgrounds     ComProc
+	GetShouldPrintSelectionOnly   ComProc
+	PutShouldPrintSelectionOnly   ComProc
+	GetShouldPrintHeaderAndFooter ComProc
+	PutShouldPrintHeaderAndFooter ComProc
+	GetHeaderTitle                ComProc
+	PutHeaderTitle                ComProc
+	GetFooterUri                  ComProc
+	PutFooterUri                  ComProc
+}
+
+type ICoreWebView2PrintSettings struct {
+	vtbl *_ICoreWebView2PrintSettingsVtbl
+}
+
+func (i *ICoreWebView2PrintSettings) AddRef() uintptr {
+	r, _, _ := i.vtbl.AddRef.Call(uintptr(unsafe.Pointer(i)))
+	return r
+}
+
+func (i *ICoreWebView2PrintSettings) Release() uintptr {
+	r, _, _ := i.vtbl.Release.Call(uintptr(unsafe.Pointer(i)))
+	return r
+}
+
+func (i *ICoreWebView2PrintSettings) PutOrientation(orientation COREWEBVIEW2_PRINT_ORIENTATION) error {
+	var err error
+	_, _, err = i.vtbl.PutOrientation.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(orientation),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
+
+func (i *ICoreWebView2PrintSettings) PutShouldPrintBackgrounds(value bool) error {
+	return i.putBool(i.vtbl.PutShouldPrintBackgrounds, value)
+}
+
+func (i *ICoreWebView2PrintSettings) PutShouldPrintSelectionOnly(value bool) error {
+	return i.putBool(i.vtbl.PutShouldPrintSelectionOnly, value)
+}
+
+func (i *ICoreWebView2PrintSettings) PutShouldPrintHeaderAndFooter(value bool) error {
+	return i.putBool(i.vtbl.PutShouldPrintHeaderAndFooter, value)
+}
+
+func (i *ICoreWebView2PrintSettings) PutHeaderTitle(value string) error {
+	return putString(i.vtbl.PutHeaderTitle, unsafe.Pointer(i), value)
+}
+
+func (i *ICoreWebView2PrintSettings) PutFooterUri(value string) error {
+	return putString(i.vtbl.PutFooterUri, unsafe.Pointer(i), value)
+}
+
+func (i *ICoreWebView2PrintSettings) putBool(proc ComProc, value bool) error {
+	var err error
+	_, _, err = proc.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(boolToInt(value)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
+
+func putString(proc ComProc, this unsafe.Pointer, value string) error {
+	_value, err := windows.UTF16PtrFromString(value)
+	if err != nil {
+		return err
+	}
+	_, _, err = proc.Call(
+		uintptr(this),
+		uintptr(unsafe.Pointer(_value)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
+
+type _ICoreWebView2PrintSettings2Vtbl struct {
+	_ICoreWebView2PrintSettingsVtbl
+	GetPageRanges   ComProc
+	PutPageRanges   ComProc
+	GetPagesPerSide ComProc
+	PutPagesPerSide ComProc
+	GetCopies       ComProc
+	PutCopies       ComProc
+	GetCollation    ComProc
+	PutCollation    ComProc
+	GetColorMode    ComProc
+	PutColorMode    ComProc
+	GetDuplex       ComProc
+	PutDuplex       ComProc
+	GetMediaSize    ComProc
+	PutMediaSize    ComProc
+	GetPrinterName  ComProc
+	PutPrinterName  ComProc
+}
+
+type ICoreWebView2PrintSettings2 struct {
+	vtbl *_ICoreWebView2PrintSettings2Vtbl
+}
+
+func (i *ICoreWebView2PrintSettings2) Release() uintptr {
+	r, _, _ := i.vtbl.Release.Call(uintptr(unsafe.Pointer(i)))
+	return r
+}
+
+func (i *ICoreWebView2PrintSettings2) PutPageRanges(value string) error {
+	return putString(i.vtbl.PutPageRanges, unsafe.Pointer(i), value)
+}
+
+func (i *ICoreWebView2PrintSettings2) PutCopies(value int32) error {
+	var err error
+	_, _, err = i.vtbl.PutCopies.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(value),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
+
+func (i *ICoreWebView2PrintSettings2) PutPrinterName(value string) error {
+	return putString(i.vtbl.PutPrinterName, unsafe.Pointer(i), value)
+}
+
+const iidICoreWebView2PrintSettings2 = "{CA7F0E1F-3484-41D1-8C1A-65CD44A63F8D}"
+
+func (i *ICoreWebView2PrintSettings) GetICoreWebView2PrintSettings2() *ICoreWebView2PrintSettings2 {
+	var result *ICoreWebView2PrintSettings2
+	_ = queryInterface(unsafe.Pointer(i), "ICoreWebView2PrintSettings2", iidICoreWebView2PrintSettings2, unsafe.Pointer(&result))
+	return result
+}
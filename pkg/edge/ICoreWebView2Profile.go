@@ -0,0 +1,123 @@
+package edge
+
+import (
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type _ICoreWebView2ProfileVtbl struct {
+	_IUnknownVtbl
+	GetProfileName               ComProc
+	GetIsInPrivateModeEnabled    ComProc
+	GetProfilePath               ComProc
+	GetDefaultDownloadFolderPath ComProc
+	PutDefaultDownloadFolderPath ComProc
+	GetPreferredColorScheme      ComProc
+	PutPreferredColorScheme      ComProc
+}
+
+type ICoreWebView2Profile struct {
+	vtbl *_ICoreWebView2ProfileVtbl
+}
+
+func (i *ICoreWebView2Profile) AddRef() uintptr {
+	r, _, _ := i.vtbl.AddRef.Call(uintptr(unsafe.Pointer(i)))
+	return r
+}
+
+func (i *ICoreWebView2Profile) Release() uintptr {
+	r, _, _ := i.vtbl.Release.Call(uintptr(unsafe.Pointer(i)))
+	return r
+}
+
+func (i *ICoreWebView2Profile) GetProfileName() (string, error) {
+	return i.getString(i.vtbl.GetProfileName)
+}
+
+func (i *ICoreWebView2Profile) GetIsInPrivateModeEnabled() (bool, error) {
+	var err error
+	var value int32
+	_, _, err = i.vtbl.GetIsInPrivateModeEnabled.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&value)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return false, err
+	}
+	return value != 0, nil
+}
+
+func (i *ICoreWebView2Profile) GetProfilePath() (string, error) {
+	return i.getString(i.vtbl.GetProfilePath)
+}
+
+func (i *ICoreWebView2Profile) GetDefaultDownloadFolderPath() (string, error) {
+	return i.getString(i.vtbl.GetDefaultDownloadFolderPath)
+}
+
+func (i *ICoreWebView2Profile) PutDefaultDownloadFolderPath(path string) error {
+	_path, err := windows.UTF16PtrFromString(path)
+	if err != nil {
+		return err
+	}
+	_, _, err = i.vtbl.PutDefaultDownloadFolderPath.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(_path)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
+
+func (i *ICoreWebView2Profile) GetPreferredColorScheme() (COREWEBVIEW2_PREFERRED_COLOR_SCHEME, error) {
+	var err error
+	var value COREWEBVIEW2_PREFERRED_COLOR_SCHEME
+	_, _, err = i.vtbl.GetPreferredColorScheme.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&value)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return 0, err
+	}
+	return value, nil
+}
+
+func (i *ICoreWebView2Profile) PutPreferredColorScheme(value COREWEBVIEW2_PREFERRED_COLOR_SCHEME) error {
+	var err error
+	_, _, err = i.vtbl.PutPreferredColorScheme.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(value),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
+
+func (i *ICoreWebView2Profile) getString(proc ComProc) (string, error) {
+	var err error
+	// Create *uint16 to hold result
+	var _value *uint16
+	_, _, err = proc.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&_value)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return "", err
+	} // Get result and cleanup
+	value := windows.UTF16PtrToString(_value)
+	windows.CoTaskMemFree(unsafe.Pointer(_value))
+	return value, nil
+}
+
+// GetProfile returns the profile of the webview. The caller must Release it.
+func (e *Chromium) GetProfile() (*ICoreWebView2Profile, error) {
+	var webview13 *ICoreWebView2_13
+	if err := e.QueryWebView("ICoreWebView2_13", iidICoreWebView2_13, unsafe.Pointer(&webview13)); err != nil {
+		return nil, err
+	}
+	defer webview13.Release()
+	return webview13.GetProfile()
+}
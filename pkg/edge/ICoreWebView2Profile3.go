@@ -0,0 +1,67 @@
+package edge
+
+import (
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type _ICoreWebView2Profile2Vtbl struct {
+	_ICoreWebView2ProfileVtbl
+	ClearBrowsingData            ComProc
+	ClearBrowsingDataInTimeRange ComProc
+	ClearBrowsingDataAll         ComProc
+}
+
+type _ICoreWebView2Profile3Vtbl struct {
+	_ICoreWebView2Profile2Vtbl
+	GetPreferredTrackingPreventionLevel ComProc
+	PutPreferredTrackingPreventionLevel ComProc
+}
+
+type ICoreWebView2Profile3 struct {
+	vtbl *_ICoreWebView2Profile3Vtbl
+}
+
+func (i *ICoreWebView2Profile3) AddRef() uintptr {
+	r, _, _ := i.vtbl.AddRef.Call(uintptr(unsafe.Pointer(i)))
+	return r
+}
+
+func (i *ICoreWebView2Profile3) Release() uintptr {
+	r, _, _ := i.vtbl.Release.Call(uintptr(unsafe.Pointer(i)))
+	return r
+}
+
+func (i *ICoreWebView2Profile3) GetPreferredTrackingPreventionLevel() (COREWEBVIEW2_TRACKING_PREVENTION_LEVEL, error) {
+	var err error
+	var value COREWEBVIEW2_TRACKING_PREVENTION_LEVEL
+	_, _, err = i.vtbl.GetPreferredTrackingPreventionLevel.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&value)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return 0, err
+	}
+	return value, nil
+}
+
+func (i *ICoreWebView2Profile3) PutPreferredTrackingPreventionLevel(value COREWEBVIEW2_TRACKING_PREVENTION_LEVEL) error {
+	var err error
+	_, _, err = i.vtbl.PutPreferredTrackingPreventionLevel.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(value),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
+
+const iidICoreWebView2Profile3 = "{b188e659-5685-4e05-bdba-fc640e0f1992}"
+
+func (i *ICoreWebView2Profile) GetICoreWebView2Profile3() *ICoreWebView2Profile3 {
+	var result *ICoreWebView2Profile3
+	_ = queryInterface(unsafe.Pointer(i), "ICoreWebView2Profile3", iidICoreWebView2Profile3, unsafe.Pointer(&result))
+	return result
+}
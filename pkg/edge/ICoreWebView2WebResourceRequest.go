@@ -41,3 +41,8 @@ func (i *ICoreWebView2WebResourceRequest) GetUri() (string, error) {
 	windows.CoTaskMemFree(unsafe.Pointer(_uri))
 	return uri, nil
 }
+
+func (i *ICoreWebView2WebResourceRequest) Release() uintptr {
+	r, _, _ := i.vtbl.Release.Call(uintptr(unsafe.Pointer(i)))
+	return r
+}
@@ -1,5 +1,7 @@
 package edge
 
+import "unsafe"
+
 type _ICoreWebView2WebResourceResponseVtbl struct {
 	_IUnknownVtbl
 	GetContent      ComProc
@@ -19,3 +21,8 @@ func (i *ICoreWebView2WebResourceResponse) AddRef() uintptr {
 	r, _, _ := i.vtbl.AddRef.Call()
 	return r
 }
+
+func (i *ICoreWebView2WebResourceResponse) Release() uintptr {
+	r, _, _ := i.vtbl.Release.Call(uintptr(unsafe.Pointer(i)))
+	return r
+}
@@ -0,0 +1,46 @@
+package edge
+
+import (
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type ICoreWebView2_11 struct {
+	vtbl *iCoreWebView2_11Vtbl
+}
+
+func (i *ICoreWebView2_11) AddRef() uintptr {
+	r, _, _ := i.vtbl.AddRef.Call(uintptr(unsafe.Pointer(i)))
+	return r
+}
+
+func (i *ICoreWebView2_11) Release() uintptr {
+	r, _, _ := i.vtbl.Release.Call(uintptr(unsafe.Pointer(i)))
+	return r
+}
+
+func (i *ICoreWebView2_11) AddContextMenuRequested(eventHandler *ICoreWebView2ContextMenuRequestedEventHandler, token *_EventRegistrationToken) error {
+	var err error
+	_, _, err = i.vtbl.AddContextMenuRequested.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(eventHandler)),
+		uintptr(unsafe.Pointer(token)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
+
+const iidICoreWebView2_11 = "{0be78e56-c193-4051-b943-23b460c08bdb}"
+
+func (i *ICoreWebView2) GetICoreWebView2_11() *ICoreWebView2_11 {
+	var result *ICoreWebView2_11
+	_ = queryInterface(unsafe.Pointer(i), "ICoreWebView2_11", iidICoreWebView2_11, unsafe.Pointer(&result))
+	return result
+}
+
+func (e *Chromium) GetICoreWebView2_11() *ICoreWebView2_11 {
+	return e.webview.GetICoreWebView2_11()
+}
@@ -0,0 +1,61 @@
+package edge
+
+import (
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type ICoreWebView2_12 struct {
+	vtbl *iCoreWebView2_12Vtbl
+}
+
+func (i *ICoreWebView2_12) AddRef() uintptr {
+	r, _, _ := i.vtbl.AddRef.Call(uintptr(unsafe.Pointer(i)))
+	return r
+}
+
+func (i *ICoreWebView2_12) Release() uintptr {
+	r, _, _ := i.vtbl.Release.Call(uintptr(unsafe.Pointer(i)))
+	return r
+}
+
+func (i *ICoreWebView2_12) AddStatusBarTextChanged(eventHandler *ICoreWebView2StatusBarTextChangedEventHandler, token *_EventRegistrationToken) error {
+	var err error
+	_, _, err = i.vtbl.AddStatusBarTextChanged.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(eventHandler)),
+		uintptr(unsafe.Pointer(token)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
+
+func (i *ICoreWebView2_12) GetStatusBarText() (string, error) {
+	var err error
+	var _value *uint16
+	_, _, err = i.vtbl.GetStatusBarText.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&_value)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return "", err
+	}
+	value := windows.UTF16PtrToString(_value)
+	windows.CoTaskMemFree(unsafe.Pointer(_value))
+	return value, nil
+}
+
+const iidICoreWebView2_12 = "{35D69927-BCFA-4566-9349-6B3E0D154CAC}"
+
+func (i *ICoreWebView2) GetICoreWebView2_12() *ICoreWebView2_12 {
+	var result *ICoreWebView2_12
+	_ = queryInterface(unsafe.Pointer(i), "ICoreWebView2_12", iidICoreWebView2_12, unsafe.Pointer(&result))
+	return result
+}
+
+func (e *Chromium) GetICoreWebView2_12() *ICoreWebView2_12 {
+	return e.webview.GetICoreWebView2_12()
+}
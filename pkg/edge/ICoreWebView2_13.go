@@ -0,0 +1,46 @@
+package edge
+
+import (
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type ICoreWebView2_13 struct {
+	vtbl *iCoreWebView2_13Vtbl
+}
+
+func (i *ICoreWebView2_13) AddRef() uintptr {
+	r, _, _ := i.vtbl.AddRef.Call(uintptr(unsafe.Pointer(i)))
+	return r
+}
+
+func (i *ICoreWebView2_13) Release() uintptr {
+	r, _, _ := i.vtbl.Release.Call(uintptr(unsafe.Pointer(i)))
+	return r
+}
+
+func (i *ICoreWebView2_13) GetProfile() (*ICoreWebView2Profile, error) {
+	var err error
+	var profile *ICoreWebView2Profile
+	_, _, err = i.vtbl.GetProfile.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&profile)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return nil, err
+	}
+	return profile, nil
+}
+
+const iidICoreWebView2_13 = "{F75F09A8-667E-4983-88D6-C8773F315E84}"
+
+func (i *ICoreWebView2) GetICoreWebView2_13() *ICoreWebView2_13 {
+	var result *ICoreWebView2_13
+	_ = queryInterface(unsafe.Pointer(i), "ICoreWebView2_13", iidICoreWebView2_13, unsafe.Pointer(&result))
+	return result
+}
+
+func (e *Chromium) GetICoreWebView2_13() *ICoreWebView2_13 {
+	return e.webview.GetICoreWebView2_13()
+}
@@ -0,0 +1,162 @@
+package edge
+
+import (
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type iCoreWebView2_4Vtbl struct {
+	iCoreWebView2_3Vtbl
+	AddFrameCreated        ComProc
+	RemoveFrameCreated     ComProc
+	AddDownloadStarting    ComProc
+	RemoveDownloadStarting ComProc
+}
+
+type iCoreWebView2_5Vtbl struct {
+	iCoreWebView2_4Vtbl
+	AddClientCertificateRequested    ComProc
+	RemoveClientCertificateRequested ComProc
+}
+
+type iCoreWebView2_6Vtbl struct {
+	iCoreWebView2_5Vtbl
+	OpenTaskManagerWindow ComProc
+}
+
+type iCoreWebView2_7Vtbl struct {
+	iCoreWebView2_6Vtbl
+	PrintToPdf ComProc
+}
+
+type iCoreWebView2_8Vtbl struct {
+	iCoreWebView2_7Vtbl
+	AddIsMutedChanged                   ComProc
+	RemoveIsMutedChanged                ComProc
+	GetIsMuted                          ComProc
+	PutIsMuted                          ComProc
+	AddIsDocumentPlayingAudioChanged    ComProc
+	RemoveIsDocumentPlayingAudioChanged ComProc
+	GetIsDocumentPlayingAudio           ComProc
+}
+
+type iCoreWebView2_9Vtbl struct {
+	iCoreWebView2_8Vtbl
+	AddIsDefaultDownloadDialogOpenChanged    ComProc
+	RemoveIsDefaultDownloadDialogOpenChanged ComProc
+	GetIsDefaultDownloadDialogOpen           ComProc
+	OpenDefaultDownloadDialog                ComProc
+	CloseDefaultDownloadDialog               ComProc
+	GetDefaultDownloadDialogCornerAlignment  ComProc
+	PutDefaultDownloadDialogCornerAlignment  ComProc
+	GetDefaultDownloadDialogMargin           ComProc
+	PutDefaultDownloadDialogMargin           ComProc
+}
+
+type iCoreWebView2_10Vtbl struct {
+	iCoreWebView2_9Vtbl
+	AddBasicAuthenticationRequested    ComProc
+	RemoveBasicAuthenticationRequested ComProc
+}
+
+type iCoreWebView2_11Vtbl struct {
+	iCoreWebView2_10Vtbl
+	CallDevToolsProtocolMethodForSession ComProc
+	AddContextMenuRequested              ComProc
+	RemoveContextMenuRequested           ComProc
+}
+
+type iCoreWebView2_12Vtbl struct {
+	iCoreWebView2_11Vtbl
+	AddStatusBarTextChanged    ComProc
+	RemoveStatusBarTextChanged ComProc
+	GetStatusBarText           ComProc
+}
+
+type iCoreWebView2_13Vtbl struct {
+	iCoreWebView2_12Vtbl
+	GetProfile ComProc
+}
+
+type iCoreWebView2_14Vtbl struct {
+	iCoreWebView2_13Vtbl
+	AddServerCertificateErrorDetected    ComProc
+	RemoveServerCertificateErrorDetected ComProc
+	ClearServerCertificateErrorActions   ComProc
+}
+
+type iCoreWebView2_15Vtbl struct {
+	iCoreWebView2_14Vtbl
+	AddFaviconChanged    ComProc
+	RemoveFaviconChanged ComProc
+	GetFaviconUri        ComProc
+	GetFavicon           ComProc
+}
+
+type ICoreWebView2_15 struct {
+	vtbl *iCoreWebView2_15Vtbl
+}
+
+func (i *ICoreWebView2_15) AddRef() uintptr {
+	r, _, _ := i.vtbl.AddRef.Call(uintptr(unsafe.Pointer(i)))
+	return r
+}
+
+func (i *ICoreWebView2_15) Release() uintptr {
+	r, _, _ := i.vtbl.Release.Call(uintptr(unsafe.Pointer(i)))
+	return r
+}
+
+func (i *ICoreWebView2_15) AddFaviconChanged(eventHandler *ICoreWebView2FaviconChangedEventHandler, token *_EventRegistrationToken) error {
+	var err error
+	_, _, err = i.vtbl.AddFaviconChanged.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(eventHandler)),
+		uintptr(unsafe.Pointer(token)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
+
+func (i *ICoreWebView2_15) GetFaviconUri() (string, error) {
+	var err error
+	var _uri *uint16
+	_, _, err = i.vtbl.GetFaviconUri.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&_uri)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return "", err
+	}
+	uri := windows.UTF16PtrToString(_uri)
+	windows.CoTaskMemFree(unsafe.Pointer(_uri))
+	return uri, nil
+}
+
+func (i *ICoreWebView2_15) GetFavicon(format COREWEBVIEW2_FAVICON_IMAGE_FORMAT, completedHandler *ICoreWebView2GetFaviconCompletedHandler) error {
+	var err error
+	_, _, err = i.vtbl.GetFavicon.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(format),
+		uintptr(unsafe.Pointer(completedHandler)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
+
+const iidICoreWebView2_15 = "{517B2D1D-7DAE-4A66-A4F4-10352FFB9518}"
+
+func (i *ICoreWebView2) GetICoreWebView2_15() *ICoreWebView2_15 {
+	var result *ICoreWebView2_15
+	_ = queryInterface(unsafe.Pointer(i), "ICoreWebView2_15", iidICoreWebView2_15, unsafe.Pointer(&result))
+	return result
+}
+
+func (e *Chromium) GetICoreWebView2_15() *ICoreWebView2_15 {
+	return e.webview.GetICoreWebView2_15()
+}
@@ -0,0 +1,67 @@
+package edge
+
+import (
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type iCoreWebView2_16Vtbl struct {
+	iCoreWebView2_15Vtbl
+	Print            ComProc
+	ShowPrintUI      ComProc
+	PrintToPdfStream ComProc
+}
+
+type ICoreWebView2_16 struct {
+	vtbl *iCoreWebView2_16Vtbl
+}
+
+func (i *ICoreWebView2_16) AddRef() uintptr {
+	r, _, _ := i.vtbl.AddRef.Call(uintptr(unsafe.Pointer(i)))
+	return r
+}
+
+func (i *ICoreWebView2_16) Release() uintptr {
+	r, _, _ := i.vtbl.Release.Call(uintptr(unsafe.Pointer(i)))
+	return r
+}
+
+// Print prints the current page without a dialog. printSettings may be nil
+// to use the default settings of the default printer.
+func (i *ICoreWebView2_16) Print(printSettings *ICoreWebView2PrintSettings, handler *ICoreWebView2PrintCompletedHandler) error {
+	var err error
+	_, _, err = i.vtbl.Print.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(printSettings)),
+		uintptr(unsafe.Pointer(handler)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
+
+func (i *ICoreWebView2_16) ShowPrintUI(printDialogKind COREWEBVIEW2_PRINT_DIALOG_KIND) error {
+	var err error
+	_, _, err = i.vtbl.ShowPrintUI.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(printDialogKind),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
+
+const iidICoreWebView2_16 = "{0EB34DC9-9F91-41E1-8639-95CD5943906B}"
+
+func (i *ICoreWebView2) GetICoreWebView2_16() *ICoreWebView2_16 {
+	var result *ICoreWebView2_16
+	_ = queryInterface(unsafe.Pointer(i), "ICoreWebView2_16", iidICoreWebView2_16, unsafe.Pointer(&result))
+	return result
+}
+
+func (e *Chromium) GetICoreWebView2_16() *ICoreWebView2_16 {
+	return e.webview.GetICoreWebView2_16()
+}
@@ -43,15 +43,11 @@ func (i *ICoreWebView2_3) SetVirtualHostNameToFolderMapping(hostName, folderPath
 	return nil
 }
 
+const iidICoreWebView2_3 = "{A0D6DF20-3B92-416D-AA0C-437A9C727857}"
+
 func (i *ICoreWebView2) GetICoreWebView2_3() *ICoreWebView2_3 {
 	var result *ICoreWebView2_3
-
-	iidICoreWebView2_3 := NewGUID("{A0D6DF20-3B92-416D-AA0C-437A9C727857}")
-	_, _, _ = i.vtbl.QueryInterface.Call(
-		uintptr(unsafe.Pointer(i)),
-		uintptr(unsafe.Pointer(iidICoreWebView2_3)),
-		uintptr(unsafe.Pointer(&result)))
-
+	_ = queryInterface(unsafe.Pointer(i), "ICoreWebView2_3", iidICoreWebView2_3, unsafe.Pointer(&result))
 	return result
 }
 
@@ -0,0 +1,46 @@
+package edge
+
+import (
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type ICoreWebView2_4 struct {
+	vtbl *iCoreWebView2_4Vtbl
+}
+
+func (i *ICoreWebView2_4) AddRef() uintptr {
+	r, _, _ := i.vtbl.AddRef.Call(uintptr(unsafe.Pointer(i)))
+	return r
+}
+
+func (i *ICoreWebView2_4) Release() uintptr {
+	r, _, _ := i.vtbl.Release.Call(uintptr(unsafe.Pointer(i)))
+	return r
+}
+
+func (i *ICoreWebView2_4) AddFrameCreated(eventHandler *ICoreWebView2FrameCreatedEventHandler, token *_EventRegistrationToken) error {
+	var err error
+	_, _, err = i.vtbl.AddFrameCreated.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(eventHandler)),
+		uintptr(unsafe.Pointer(token)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
+
+const iidICoreWebView2_4 = "{20d02d59-6df2-42dc-bd06-f98a694b1302}"
+
+func (i *ICoreWebView2) GetICoreWebView2_4() *ICoreWebView2_4 {
+	var result *ICoreWebView2_4
+	_ = queryInterface(unsafe.Pointer(i), "ICoreWebView2_4", iidICoreWebView2_4, unsafe.Pointer(&result))
+	return result
+}
+
+func (e *Chromium) GetICoreWebView2_4() *ICoreWebView2_4 {
+	return e.webview.GetICoreWebView2_4()
+}
@@ -0,0 +1,84 @@
+package edge
+
+import (
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type ICoreWebView2_8 struct {
+	vtbl *iCoreWebView2_8Vtbl
+}
+
+func (i *ICoreWebView2_8) AddRef() uintptr {
+	r, _, _ := i.vtbl.AddRef.Call(uintptr(unsafe.Pointer(i)))
+	return r
+}
+
+func (i *ICoreWebView2_8) Release() uintptr {
+	r, _, _ := i.vtbl.Release.Call(uintptr(unsafe.Pointer(i)))
+	return r
+}
+
+func (i *ICoreWebView2_8) GetIsMuted() (bool, error) {
+	var err error
+	var isMuted int32
+	_, _, err = i.vtbl.GetIsMuted.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&isMuted)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return false, err
+	}
+	return isMuted != 0, nil
+}
+
+func (i *ICoreWebView2_8) PutIsMuted(isMuted bool) error {
+	var err error
+	_, _, err = i.vtbl.PutIsMuted.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(boolToInt(isMuted)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
+
+func (i *ICoreWebView2_8) AddIsDocumentPlayingAudioChanged(eventHandler *ICoreWebView2IsDocumentPlayingAudioChangedEventHandler, token *_EventRegistrationToken) error {
+	var err error
+	_, _, err = i.vtbl.AddIsDocumentPlayingAudioChanged.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(eventHandler)),
+		uintptr(unsafe.Pointer(token)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
+
+func (i *ICoreWebView2_8) GetIsDocumentPlayingAudio() (bool, error) {
+	var err error
+	var isPlaying int32
+	_, _, err = i.vtbl.GetIsDocumentPlayingAudio.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&isPlaying)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return false, err
+	}
+	return isPlaying != 0, nil
+}
+
+const iidICoreWebView2_8 = "{E9632730-6E1E-43AB-B7B8-7B2C9E62E094}"
+
+func (i *ICoreWebView2) GetICoreWebView2_8() *ICoreWebView2_8 {
+	var result *ICoreWebView2_8
+	_ = queryInterface(unsafe.Pointer(i), "ICoreWebView2_8", iidICoreWebView2_8, unsafe.Pointer(&result))
+	return result
+}
+
+func (e *Chromium) GetICoreWebView2_8() *ICoreWebView2_8 {
+	return e.webview.GetICoreWebView2_8()
+}
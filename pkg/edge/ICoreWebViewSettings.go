@@ -46,6 +46,13 @@ type ICoreWebViewSettings struct {
 	vtbl *_ICoreWebViewSettingsVtbl
 }
 
+// Supports reports whether the settings object implements the interface iid.
+// The methods of the merged vtbl that were added by a later settings
+// interface, e.g. ICoreWebView2Settings5, must only be called if it does.
+func (i *ICoreWebViewSettings) Supports(iid string) bool {
+	return supportsInterface(unsafe.Pointer(i), iid)
+}
+
 func (i *ICoreWebViewSettings) AddRef() uintptr {
 	r, _, _ := i.vtbl.AddRef.Call()
 	return r
@@ -343,6 +350,58 @@ func (i *ICoreWebViewSettings) PutAreBrowserAcceleratorKeysEnabled(enabled bool)
 	return nil
 }
 
+func (i *ICoreWebViewSettings) GetIsPasswordAutosaveEnabled() (bool, error) {
+	var err error
+	var enabled int32
+	_, _, err = i.vtbl.GetIsPasswordAutosaveEnabled.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&enabled)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return false, err
+	}
+	return enabled != 0, nil
+}
+
+func (i *ICoreWebViewSettings) PutIsPasswordAutosaveEnabled(enabled bool) error {
+	var err error
+
+	_, _, err = i.vtbl.PutIsPasswordAutosaveEnabled.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(boolToInt(enabled)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
+
+func (i *ICoreWebViewSettings) GetIsGeneralAutofillEnabled() (bool, error) {
+	var err error
+	var enabled int32
+	_, _, err = i.vtbl.GetIsGeneralAutofillEnabled.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&enabled)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return false, err
+	}
+	return enabled != 0, nil
+}
+
+func (i *ICoreWebViewSettings) PutIsGeneralAutofillEnabled(enabled bool) error {
+	var err error
+
+	_, _, err = i.vtbl.PutIsGeneralAutofillEnabled.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(boolToInt(enabled)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
+
 func (i *ICoreWebViewSettings) GetIsPinchZoomEnabled() (bool, error) {
 	var err error
 	var enabled bool
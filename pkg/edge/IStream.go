@@ -0,0 +1,93 @@
+package edge
+
+import (
+	"io"
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type _IStreamVtbl struct {
+	_IUnknownVtbl
+	Read         ComProc
+	Write        ComProc
+	Seek         ComProc
+	SetSize      ComProc
+	CopyTo       ComProc
+	Commit       ComProc
+	Revert       ComProc
+	LockRegion   ComProc
+	UnlockRegion ComProc
+	Stat         ComProc
+	Clone        ComProc
+}
+
+type IStream struct {
+	vtbl *_IStreamVtbl
+}
+
+func (i *IStream) AddRef() uintptr {
+	r, _, _ := i.vtbl.AddRef.Call(uintptr(unsafe.Pointer(i)))
+	return r
+}
+
+func (i *IStream) Release() uintptr {
+	r, _, _ := i.vtbl.Release.Call(uintptr(unsafe.Pointer(i)))
+	return r
+}
+
+// Read implements io.Reader on top of ISequentialStream::Read.
+func (i *IStream) Read(p []byte) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+
+	var n uint32
+	hr, _, _ := i.vtbl.Read.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&p[0])),
+		uintptr(len(p)),
+		uintptr(unsafe.Pointer(&n)),
+	)
+
+	switch windows.Handle(hr) {
+	case windows.S_OK:
+		if n == 0 {
+			return 0, io.EOF
+		}
+		return int(n), nil
+	case windows.S_FALSE:
+		return int(n), io.EOF
+	default:
+		return int(n), syscall.Errno(hr)
+	}
+}
+
+// Seek implements io.Seeker on top of IStream::Seek. The whence values of
+// io.Seeker match STREAM_SEEK_SET, STREAM_SEEK_CUR and STREAM_SEEK_END.
+func (i *IStream) Seek(offset int64, whence int) (int64, error) {
+	var position uint64
+	var hr uintptr
+	if unsafe.Sizeof(uintptr(0)) == 8 {
+		hr, _, _ = i.vtbl.Seek.Call(
+			uintptr(unsafe.Pointer(i)),
+			uintptr(offset),
+			uintptr(whence),
+			uintptr(unsafe.Pointer(&position)),
+		)
+	} else {
+		// The LARGE_INTEGER is passed by value in two words on 386.
+		hr, _, _ = i.vtbl.Seek.Call(
+			uintptr(unsafe.Pointer(i)),
+			uintptr(uint32(offset)),
+			uintptr(uint64(offset)>>32),
+			uintptr(whence),
+			uintptr(unsafe.Pointer(&position)),
+		)
+	}
+	if int32(hr) < 0 {
+		return int64(position), syscall.Errno(hr)
+	}
+	return int64(position), nil
+}
@@ -4,10 +4,14 @@
 package edge
 
 import (
+	"errors"
+	"fmt"
+	"io"
 	"log"
 	"os"
 	"path/filepath"
 	"sync/atomic"
+	"syscall"
 	"unsafe"
 
 	"github.com/mzky/go-webview2/internal/w32"
@@ -16,6 +20,7 @@ import (
 
 type Chromium struct {
 	hwnd                  uintptr
+	bounds                *w32.Rect
 	focusOnInit           bool
 	controller            *ICoreWebView2Controller
 	webview               *ICoreWebView2
@@ -27,11 +32,52 @@ type Chromium struct {
 	webResourceRequested  *iCoreWebView2WebResourceRequestedEventHandler
 	acceleratorKeyPressed *ICoreWebView2AcceleratorKeyPressedEventHandler
 	navigationCompleted   *ICoreWebView2NavigationCompletedEventHandler
+	navigationStarting    *ICoreWebView2NavigationStartingEventHandler
+	pendingScripts        map[*scriptCompleted]struct{}
+	pendingAddScripts     map[*addScriptCompleted]struct{}
+	pendingPrints         map[*printCompleted]struct{}
+	pendingCookies        map[*cookiesCompleted]struct{}
+	pendingDevTools       map[*devToolsCompleted]struct{}
+	pendingCaptures       map[*captureCompleted]struct{}
+	processExited         *browserProcessExited
+	devToolsEvents        []*devToolsEvent
+	frameCreated          *ICoreWebView2FrameCreatedEventHandler
+	responseReceived      *ICoreWebView2WebResourceResponseReceivedEventHandler
+	contextMenuRequested  *ICoreWebView2ContextMenuRequestedEventHandler
+	statusBarTextChanged  *ICoreWebView2StatusBarTextChangedEventHandler
+	domContentLoaded      *ICoreWebView2DOMContentLoadedEventHandler
+	frames                []*ICoreWebView2Frame
+	faviconChanged        *ICoreWebView2FaviconChangedEventHandler
+	getFaviconCompleted   *ICoreWebView2GetFaviconCompletedHandler
+	playingAudioChanged   *ICoreWebView2IsDocumentPlayingAudioChangedEventHandler
+	documentTitleChanged  *ICoreWebView2DocumentTitleChangedEventHandler
 
-	environment *ICoreWebView2Environment
+	environment        *ICoreWebView2Environment
+	environmentOptions *ICoreWebView2EnvironmentOptions
+
+	embedErr error
+
+	compositionController          *ICoreWebView2CompositionController
+	compositionControllerCompleted *iCoreWebView2CreateCoreWebView2CompositionControllerCompletedHandler
 
 	// Settings
 	DataPath string
+	dataPath string
+
+	// CustomSchemes are registered with the environment when it is created,
+	// so they must be set before Embed.
+	CustomSchemes []*ICoreWebView2CustomSchemeRegistration
+
+	// AdditionalBrowserArguments are the command line switches passed to the
+	// browser process, e.g. "--autoplay-policy=no-user-gesture-required".
+	AdditionalBrowserArguments string
+
+	// CompositionHosting creates a composition controller instead of a
+	// windowed one, so that the webview can be attached to a visual tree
+	// with SetRootVisualTarget. The host window must forward mouse input.
+	// Runtimes without ICoreWebView2Environment3 fall back to windowed
+	// hosting.
+	CompositionHosting bool
 
 	// permissions
 	permissions      map[CoreWebView2PermissionKind]CoreWebView2PermissionState
@@ -39,9 +85,24 @@ type Chromium struct {
 
 	// Callbacks
 	MessageCallback              func(string)
+	MessageSourceCallback        func(message, source string)
+	WebMessageJSONCallback       func(string)
 	WebResourceRequestedCallback func(request *ICoreWebView2WebResourceRequest, args *ICoreWebView2WebResourceRequestedEventArgs)
 	NavigationCompletedCallback  func(sender *ICoreWebView2, args *ICoreWebView2NavigationCompletedEventArgs)
+	NavigationStartingCallback   func(sender *ICoreWebView2, args *ICoreWebView2NavigationStartingEventArgs)
 	AcceleratorKeyCallback       func(uint) bool
+	FaviconChangedCallback       func(icon []byte, mime string)
+	AudioPlaybackChangedCallback func(playing bool)
+	DocumentTitleChangedCallback func(title string)
+	StatusBarTextChangedCallback func(text string)
+	DOMContentLoadedCallback     func()
+	// WebResourceResponseReceivedCallback observes the responses of all
+	// requests made by the page. The request and response are only valid
+	// during the call.
+	WebResourceResponseReceivedCallback func(request *ICoreWebView2WebResourceRequest, response *ICoreWebView2WebResourceResponseView)
+	// ContextMenuRequestedCallback may change the items of a default context
+	// menu before it is shown.
+	ContextMenuRequestedCallback func(args *ICoreWebView2ContextMenuRequestedEventArgs)
 }
 
 func NewChromium() *Chromium {
@@ -59,17 +120,40 @@ func NewChromium() *Chromium {
 	*/
 	e.envCompleted = newICoreWebView2CreateCoreWebView2EnvironmentCompletedHandler(e)
 	e.controllerCompleted = newICoreWebView2CreateCoreWebView2ControllerCompletedHandler(e)
+	e.compositionControllerCompleted = newICoreWebView2CreateCoreWebView2CompositionControllerCompletedHandler(e)
 	e.webMessageReceived = newICoreWebView2WebMessageReceivedEventHandler(e)
 	e.permissionRequested = newICoreWebView2PermissionRequestedEventHandler(e)
 	e.webResourceRequested = newICoreWebView2WebResourceRequestedEventHandler(e)
 	e.acceleratorKeyPressed = newICoreWebView2AcceleratorKeyPressedEventHandler(e)
 	e.navigationCompleted = newICoreWebView2NavigationCompletedEventHandler(e)
+	e.navigationStarting = newICoreWebView2NavigationStartingEventHandler(e)
+	e.faviconChanged = newICoreWebView2FaviconChangedEventHandler(e)
+	e.getFaviconCompleted = newICoreWebView2GetFaviconCompletedHandler(e)
+	e.playingAudioChanged = newICoreWebView2IsDocumentPlayingAudioChangedEventHandler(e)
+	e.documentTitleChanged = newICoreWebView2DocumentTitleChangedEventHandler(e)
+	e.frameCreated = newICoreWebView2FrameCreatedEventHandler(e)
+	e.responseReceived = newICoreWebView2WebResourceResponseReceivedEventHandler(e)
+	e.contextMenuRequested = newICoreWebView2ContextMenuRequestedEventHandler(e)
+	e.statusBarTextChanged = newICoreWebView2StatusBarTextChangedEventHandler(e)
+	e.domContentLoaded = newICoreWebView2DOMContentLoadedEventHandler(e)
 	e.permissions = make(map[CoreWebView2PermissionKind]CoreWebView2PermissionState)
 
 	return e
 }
 
+// Embed creates the webview in hwnd and waits until it is ready. Failures are
+// logged; use EmbedE to get the error instead.
 func (e *Chromium) Embed(hwnd uintptr) bool {
+	if err := e.EmbedE(hwnd); err != nil {
+		log.Printf("Embedding the webview failed: %v", err)
+		return false
+	}
+	return true
+}
+
+// EmbedE is like Embed but returns why embedding failed, e.g. the HRESULT
+// with which the runtime refused to create the environment or controller.
+func (e *Chromium) EmbedE(hwnd uintptr) error {
 	e.hwnd = hwnd
 
 	dataPath := e.DataPath
@@ -77,20 +161,29 @@ func (e *Chromium) Embed(hwnd uintptr) bool {
 		currentExePath := make([]uint16, windows.MAX_PATH)
 		_, err := windows.GetModuleFileName(windows.Handle(0), &currentExePath[0], windows.MAX_PATH)
 		if err != nil {
-			// What to do here?
-			return false
+			return fmt.Errorf("getting the executable name: %w", err)
 		}
 		currentExeName := filepath.Base(windows.UTF16ToString(currentExePath))
 		dataPath = filepath.Join(os.Getenv("AppData"), currentExeName)
 	}
+	if err := checkDataPath(dataPath); err != nil {
+		return fmt.Errorf("data path %s is not usable: %w", dataPath, err)
+	}
+	e.dataPath = dataPath
+
+	var options uintptr
+	if len(e.CustomSchemes) > 0 || e.AdditionalBrowserArguments != "" {
+		e.environmentOptions = NewICoreWebView2EnvironmentOptions()
+		e.environmentOptions.CustomSchemeRegistrations = e.CustomSchemes
+		e.environmentOptions.AdditionalBrowserArguments = e.AdditionalBrowserArguments
+		options = uintptr(unsafe.Pointer(e.environmentOptions))
+	}
 
-	res, err := createCoreWebView2EnvironmentWithOptions(nil, windows.StringToUTF16Ptr(dataPath), 0, e.envCompleted)
+	res, err := createCoreWebView2EnvironmentWithOptions(nil, windows.StringToUTF16Ptr(dataPath), options, e.envCompleted)
 	if err != nil {
-		log.Printf("Error calling Webview2Loader: %v", err)
-		return false
+		return fmt.Errorf("calling WebView2Loader: %w", err)
 	} else if res != 0 {
-		log.Printf("Result: %08x", res)
-		return false
+		return fmt.Errorf("CreateCoreWebView2EnvironmentWithOptions failed with HRESULT 0x%08X: %w", uint32(res), windows.Errno(uint32(res)))
 	}
 	var msg w32.Msg
 	for {
@@ -109,15 +202,157 @@ func (e *Chromium) Embed(hwnd uintptr) bool {
 		_, _, _ = w32.User32TranslateMessage.Call(uintptr(unsafe.Pointer(&msg)))
 		_, _, _ = w32.User32DispatchMessageW.Call(uintptr(unsafe.Pointer(&msg)))
 	}
+	if e.embedErr != nil {
+		return e.embedErr
+	}
+	if atomic.LoadUintptr(&e.inited) == 0 {
+		return errors.New("the message loop quit before the webview was ready")
+	}
 	e.Init("window.external={invoke:s=>window.chrome.webview.postMessage(s)}")
-	return true
+	return nil
+}
+
+// embedFailed ends the wait in EmbedE with err.
+func (e *Chromium) embedFailed(err error) {
+	e.embedErr = err
+	atomic.StoreUintptr(&e.inited, 1)
+}
+
+// checkDataPath makes sure the user data folder exists and is writable, which
+// otherwise surfaces as an obscure failure while creating the environment.
+func checkDataPath(dataPath string) error {
+	if err := os.MkdirAll(dataPath, 0755); err != nil {
+		return err
+	}
+	f, err := os.CreateTemp(dataPath, ".probe")
+	if err != nil {
+		return err
+	}
+	_ = f.Close()
+	return os.Remove(f.Name())
+}
+
+// UserDataFolder returns the user data folder the environment actually uses.
+// Runtimes lacking ICoreWebView2Environment7 report the folder passed at
+// creation instead.
+func (e *Chromium) UserDataFolder() string {
+	if e.environment != nil {
+		if env7 := e.environment.GetICoreWebView2Environment7(); env7 != nil {
+			defer env7.Release()
+			if folder, err := env7.GetUserDataFolder(); err == nil {
+				return folder
+			}
+		}
+	}
+	return e.dataPath
 }
 
-func (e *Chromium) Navigate(url string) {
-	_, _, _ = e.webview.vtbl.Navigate.Call(
+// BrowserVersion returns the version of the runtime the environment was
+// created with, or "" before the environment exists.
+func (e *Chromium) BrowserVersion() string {
+	if e.environment == nil {
+		return ""
+	}
+	version, err := e.environment.GetBrowserVersionString()
+	if err != nil {
+		return ""
+	}
+	return version
+}
+
+// Navigate starts navigating to url. An error means that the runtime refused
+// the URL, e.g. with E_INVALIDARG, and no NavigationStarting event follows.
+func (e *Chromium) Navigate(url string) error {
+	_url, err := windows.UTF16PtrFromString(url)
+	if err != nil {
+		return err
+	}
+	_, _, err = e.webview.vtbl.Navigate.Call(
 		uintptr(unsafe.Pointer(e.webview)),
-		uintptr(unsafe.Pointer(windows.StringToUTF16Ptr(url))),
+		uintptr(unsafe.Pointer(_url)),
 	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
+
+// Stop stops all navigations and pending resource fetches.
+func (e *Chromium) Stop() {
+	_, _, _ = e.webview.vtbl.Stop.Call(uintptr(unsafe.Pointer(e.webview)))
+}
+
+// Close closes the controller and releases the webview and environment,
+// which ends the browser process once no other WebView2 uses the same user
+// data folder. If exited is not nil, it is called on the main thread once the
+// browser process has exited, or right away if the runtime can't report
+// that. The Chromium can't be used afterwards.
+func (e *Chromium) Close(exited func()) {
+	if e.controller == nil {
+		if exited != nil {
+			exited()
+		}
+		return
+	}
+	_ = e.controller.Close()
+	e.controller.Release()
+	e.controller = nil
+	if e.compositionController != nil {
+		e.compositionController.Release()
+		e.compositionController = nil
+	}
+	e.webview.Release()
+
+	env := e.environment
+	e.environment = nil
+	if exited == nil {
+		env.Release()
+		return
+	}
+	env5 := env.GetICoreWebView2Environment5()
+	if env5 == nil {
+		env.Release()
+		exited()
+		return
+	}
+	// The environment raises the event, so it is only released afterwards.
+	e.processExited = &browserProcessExited{env: env, env5: env5, callback: exited}
+	e.processExited.handler = newICoreWebView2BrowserProcessExitedEventHandler(e.processExited)
+	var token _EventRegistrationToken
+	if err := env5.AddBrowserProcessExited(e.processExited.handler, &token); err != nil {
+		e.processExited.BrowserProcessExited(env, nil)
+	}
+}
+
+type browserProcessExited struct {
+	env      *ICoreWebView2Environment
+	env5     *ICoreWebView2Environment5
+	handler  *ICoreWebView2BrowserProcessExitedEventHandler
+	callback func()
+}
+
+func (b *browserProcessExited) QueryInterface(_, _ uintptr) uintptr {
+	return 0
+}
+
+func (b *browserProcessExited) AddRef() uintptr {
+	return 1
+}
+
+func (b *browserProcessExited) Release() uintptr {
+	return 1
+}
+
+func (b *browserProcessExited) BrowserProcessExited(_ *ICoreWebView2Environment, _ *ICoreWebView2BrowserProcessExitedEventArgs) uintptr {
+	if b.callback == nil {
+		return 0
+	}
+	callback := b.callback
+	b.callback = nil
+	b.env5.Release()
+	b.env.Release()
+	callback()
+	return 0
 }
 
 func (e *Chromium) NavigateToString(htmlContent string) {
@@ -135,6 +370,83 @@ func (e *Chromium) Init(script string) {
 	)
 }
 
+// AddScriptToExecuteOnDocumentCreated is like Init, but calls callback with
+// the id of the script, which RemoveScriptToExecuteOnDocumentCreated takes to
+// unregister it again.
+func (e *Chromium) AddScriptToExecuteOnDocumentCreated(script string, callback func(id string, err error)) error {
+	_script, err := windows.UTF16PtrFromString(script)
+	if err != nil {
+		return err
+	}
+
+	// Kept reachable until the completion fires, like pendingScripts.
+	pending := &addScriptCompleted{chromium: e, callback: callback}
+	pending.handler = newICoreWebView2AddScriptToExecuteOnDocumentCreatedCompletedHandler(pending)
+	if e.pendingAddScripts == nil {
+		e.pendingAddScripts = make(map[*addScriptCompleted]struct{})
+	}
+	e.pendingAddScripts[pending] = struct{}{}
+
+	_, _, err = e.webview.vtbl.AddScriptToExecuteOnDocumentCreated.Call(
+		uintptr(unsafe.Pointer(e.webview)),
+		uintptr(unsafe.Pointer(_script)),
+		uintptr(unsafe.Pointer(pending.handler)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		delete(e.pendingAddScripts, pending)
+		return err
+	}
+	return nil
+}
+
+// RemoveScriptToExecuteOnDocumentCreated unregisters the script with the
+// given id. Documents that already ran it are not affected.
+func (e *Chromium) RemoveScriptToExecuteOnDocumentCreated(id string) error {
+	_id, err := windows.UTF16PtrFromString(id)
+	if err != nil {
+		return err
+	}
+	_, _, err = e.webview.vtbl.RemoveScriptToExecuteOnDocumentCreated.Call(
+		uintptr(unsafe.Pointer(e.webview)),
+		uintptr(unsafe.Pointer(_id)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
+
+type addScriptCompleted struct {
+	chromium *Chromium
+	handler  *ICoreWebView2AddScriptToExecuteOnDocumentCreatedCompletedHandler
+	callback func(id string, err error)
+}
+
+func (a *addScriptCompleted) QueryInterface(_, _ uintptr) uintptr {
+	return 0
+}
+
+func (a *addScriptCompleted) AddRef() uintptr {
+	return 1
+}
+
+func (a *addScriptCompleted) Release() uintptr {
+	return 1
+}
+
+func (a *addScriptCompleted) AddScriptToExecuteOnDocumentCreatedCompleted(errorCode uintptr, id *uint16) uintptr {
+	delete(a.chromium.pendingAddScripts, a)
+	if a.callback == nil {
+		return 0
+	}
+	if int32(errorCode) < 0 {
+		a.callback("", syscall.Errno(errorCode))
+		return 0
+	}
+	a.callback(w32.Utf16PtrToString(id), nil)
+	return 0
+}
+
 func (e *Chromium) Eval(script string) {
 	_script, err := windows.UTF16PtrFromString(script)
 	if err != nil {
@@ -148,6 +460,363 @@ func (e *Chromium) Eval(script string) {
 	)
 }
 
+// CompositionController returns the composition controller, or nil unless
+// the webview was created with CompositionHosting.
+func (e *Chromium) CompositionController() *ICoreWebView2CompositionController {
+	return e.compositionController
+}
+
+// SetRootVisualTarget attaches the webview to visual, the IUnknown pointer of
+// an IDCompositionVisual or a Windows.UI.Composition ContainerVisual.
+func (e *Chromium) SetRootVisualTarget(visual unsafe.Pointer) error {
+	if e.compositionController == nil {
+		return errors.New("the webview was not created with CompositionHosting")
+	}
+	return e.compositionController.PutRootVisualTarget(uintptr(visual))
+}
+
+// PostWebMessageAsJSON posts the JSON encoded message to the top-level
+// document, where it arrives as the data of a window.chrome.webview
+// "message" event.
+func (e *Chromium) PostWebMessageAsJSON(message string) error {
+	_message, err := windows.UTF16PtrFromString(message)
+	if err != nil {
+		return err
+	}
+	_, _, err = e.webview.vtbl.PostWebMessageAsJSON.Call(
+		uintptr(unsafe.Pointer(e.webview)),
+		uintptr(unsafe.Pointer(_message)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
+
+// ExecuteScript runs script in the top-level document and calls callback
+// with the JSON encoded result once it has completed. Errors returned by the
+// runtime are passed to callback as well.
+func (e *Chromium) ExecuteScript(script string, callback func(result string, err error)) error {
+	_script, err := windows.UTF16PtrFromString(script)
+	if err != nil {
+		return err
+	}
+
+	pending := e.newScriptCompleted(callback)
+	_, _, err = e.webview.vtbl.ExecuteScript.Call(
+		uintptr(unsafe.Pointer(e.webview)),
+		uintptr(unsafe.Pointer(_script)),
+		uintptr(unsafe.Pointer(pending.handler)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		delete(e.pendingScripts, pending)
+		return err
+	}
+	return nil
+}
+
+// ExecuteScriptInFrame is like ExecuteScript, but runs script in frame.
+func (e *Chromium) ExecuteScriptInFrame(frame *ICoreWebView2Frame, script string, callback func(result string, err error)) error {
+	var frame2 *ICoreWebView2Frame2
+	if err := queryInterface(unsafe.Pointer(frame), "ICoreWebView2Frame2", iidICoreWebView2Frame2, unsafe.Pointer(&frame2)); err != nil {
+		return err
+	}
+	defer frame2.Release()
+
+	pending := e.newScriptCompleted(callback)
+	if err := frame2.ExecuteScript(script, pending.handler); err != nil {
+		delete(e.pendingScripts, pending)
+		return err
+	}
+	return nil
+}
+
+// newScriptCompleted creates the completion handler of a script. The runtime
+// only holds a raw pointer to the handler, so it is kept reachable until the
+// completion fires.
+func (e *Chromium) newScriptCompleted(callback func(result string, err error)) *scriptCompleted {
+	pending := &scriptCompleted{chromium: e, callback: callback}
+	pending.handler = newICoreWebView2ExecuteScriptCompletedHandler(pending)
+	if e.pendingScripts == nil {
+		e.pendingScripts = make(map[*scriptCompleted]struct{})
+	}
+	e.pendingScripts[pending] = struct{}{}
+	return pending
+}
+
+type scriptCompleted struct {
+	chromium *Chromium
+	handler  *ICoreWebView2ExecuteScriptCompletedHandler
+	callback func(result string, err error)
+}
+
+func (s *scriptCompleted) QueryInterface(_, _ uintptr) uintptr {
+	return 0
+}
+
+func (s *scriptCompleted) AddRef() uintptr {
+	return 1
+}
+
+func (s *scriptCompleted) Release() uintptr {
+	return 1
+}
+
+func (s *scriptCompleted) ExecuteScriptCompleted(errorCode uintptr, resultObjectAsJson *uint16) uintptr {
+	delete(s.chromium.pendingScripts, s)
+	if s.callback == nil {
+		return 0
+	}
+	if int32(errorCode) < 0 {
+		s.callback("", syscall.Errno(errorCode))
+		return 0
+	}
+	s.callback(w32.Utf16PtrToString(resultObjectAsJson), nil)
+	return 0
+}
+
+// Print prints the current page without a dialog and calls callback with the
+// outcome once the job has been handed to the printer. printSettings may be
+// nil to use the defaults of the default printer.
+func (e *Chromium) Print(printSettings *ICoreWebView2PrintSettings, callback func(status COREWEBVIEW2_PRINT_STATUS, err error)) error {
+	var webview16 *ICoreWebView2_16
+	if err := e.QueryWebView("ICoreWebView2_16", iidICoreWebView2_16, unsafe.Pointer(&webview16)); err != nil {
+		return err
+	}
+	defer webview16.Release()
+
+	// Kept reachable until the completion fires, like pendingScripts.
+	pending := &printCompleted{chromium: e, callback: callback}
+	pending.handler = newICoreWebView2PrintCompletedHandler(pending)
+	if e.pendingPrints == nil {
+		e.pendingPrints = make(map[*printCompleted]struct{})
+	}
+	e.pendingPrints[pending] = struct{}{}
+
+	if err := webview16.Print(printSettings, pending.handler); err != nil {
+		delete(e.pendingPrints, pending)
+		return err
+	}
+	return nil
+}
+
+type printCompleted struct {
+	chromium *Chromium
+	handler  *ICoreWebView2PrintCompletedHandler
+	callback func(status COREWEBVIEW2_PRINT_STATUS, err error)
+}
+
+func (p *printCompleted) QueryInterface(_, _ uintptr) uintptr {
+	return 0
+}
+
+func (p *printCompleted) AddRef() uintptr {
+	return 1
+}
+
+func (p *printCompleted) Release() uintptr {
+	return 1
+}
+
+func (p *printCompleted) PrintCompleted(errorCode uintptr, result COREWEBVIEW2_PRINT_STATUS) uintptr {
+	delete(p.chromium.pendingPrints, p)
+	if p.callback == nil {
+		return 0
+	}
+	if int32(errorCode) < 0 {
+		p.callback(result, syscall.Errno(errorCode))
+		return 0
+	}
+	p.callback(result, nil)
+	return 0
+}
+
+// CapturePreview captures the visible part of the webview as an image in
+// format and calls callback with the encoded image once it has completed.
+func (e *Chromium) CapturePreview(format COREWEBVIEW2_CAPTURE_PREVIEW_IMAGE_FORMAT, callback func(image []byte, err error)) error {
+	stream, err := w32.SHCreateMemStream(nil)
+	if err != nil {
+		return err
+	}
+
+	// Kept reachable until the completion fires, like pendingScripts.
+	pending := &captureCompleted{chromium: e, stream: (*IStream)(stream), callback: callback}
+	pending.handler = newICoreWebView2CapturePreviewCompletedHandler(pending)
+	if e.pendingCaptures == nil {
+		e.pendingCaptures = make(map[*captureCompleted]struct{})
+	}
+	e.pendingCaptures[pending] = struct{}{}
+
+	if err := e.webview.CapturePreview(format, pending.stream, pending.handler); err != nil {
+		delete(e.pendingCaptures, pending)
+		pending.stream.Release()
+		return err
+	}
+	return nil
+}
+
+type captureCompleted struct {
+	chromium *Chromium
+	handler  *ICoreWebView2CapturePreviewCompletedHandler
+	stream   *IStream
+	callback func(image []byte, err error)
+}
+
+func (c *captureCompleted) QueryInterface(_, _ uintptr) uintptr {
+	return 0
+}
+
+func (c *captureCompleted) AddRef() uintptr {
+	return 1
+}
+
+func (c *captureCompleted) Release() uintptr {
+	return 1
+}
+
+func (c *captureCompleted) CapturePreviewCompleted(errorCode uintptr) uintptr {
+	delete(c.chromium.pendingCaptures, c)
+	defer c.stream.Release()
+	if c.callback == nil {
+		return 0
+	}
+	if int32(errorCode) < 0 {
+		c.callback(nil, syscall.Errno(errorCode))
+		return 0
+	}
+	if _, err := c.stream.Seek(0, io.SeekStart); err != nil {
+		c.callback(nil, err)
+		return 0
+	}
+	image, err := io.ReadAll(c.stream)
+	c.callback(image, err)
+	return 0
+}
+
+// CallDevToolsProtocolMethod calls method of the Chrome DevTools Protocol with
+// the JSON encoded params, e.g. "{}", and calls callback with the JSON encoded
+// result once it has completed. If the method fails, the error wraps the
+// runtime's HRESULT and includes the JSON encoded CDP error object.
+func (e *Chromium) CallDevToolsProtocolMethod(method, params string, callback func(result string, err error)) error {
+	// Kept reachable until the completion fires, like pendingScripts.
+	pending := &devToolsCompleted{chromium: e, method: method, callback: callback}
+	pending.handler = newICoreWebView2CallDevToolsProtocolMethodCompletedHandler(pending)
+	if e.pendingDevTools == nil {
+		e.pendingDevTools = make(map[*devToolsCompleted]struct{})
+	}
+	e.pendingDevTools[pending] = struct{}{}
+
+	if err := e.webview.CallDevToolsProtocolMethod(method, params, pending.handler); err != nil {
+		delete(e.pendingDevTools, pending)
+		return err
+	}
+	return nil
+}
+
+type devToolsCompleted struct {
+	chromium *Chromium
+	method   string
+	handler  *ICoreWebView2CallDevToolsProtocolMethodCompletedHandler
+	callback func(result string, err error)
+}
+
+func (d *devToolsCompleted) QueryInterface(_, _ uintptr) uintptr {
+	return 0
+}
+
+func (d *devToolsCompleted) AddRef() uintptr {
+	return 1
+}
+
+func (d *devToolsCompleted) Release() uintptr {
+	return 1
+}
+
+func (d *devToolsCompleted) CallDevToolsProtocolMethodCompleted(errorCode uintptr, returnObjectAsJson *uint16) uintptr {
+	delete(d.chromium.pendingDevTools, d)
+	if d.callback == nil {
+		return 0
+	}
+	if int32(errorCode) < 0 {
+		// The runtime passes the CDP error object, e.g.
+		// {"code":-32601,"message":"'Foo.bar' wasn't found"}, as the result.
+		err := error(syscall.Errno(errorCode))
+		if detail := w32.Utf16PtrToString(returnObjectAsJson); detail != "" {
+			err = fmt.Errorf("%s: %w: %s", d.method, err, detail)
+		}
+		d.callback("", err)
+		return 0
+	}
+	d.callback(w32.Utf16PtrToString(returnObjectAsJson), nil)
+	return 0
+}
+
+// SubscribeDevToolsEvent calls callback with the JSON encoded parameters of
+// every Chrome DevTools Protocol event named event, e.g.
+// "Network.requestWillBeSent". Most domains only send events after their
+// enable method has been called with CallDevToolsProtocolMethod.
+func (e *Chromium) SubscribeDevToolsEvent(event string, callback func(params string)) error {
+	receiver, err := e.webview.GetDevToolsProtocolEventReceiver(event)
+	if err != nil {
+		return err
+	}
+	defer receiver.Release()
+
+	// The runtime only holds a raw pointer to the handler, so it is kept
+	// reachable for the lifetime of the webview.
+	subscription := &devToolsEvent{callback: callback}
+	subscription.handler = newICoreWebView2DevToolsProtocolEventReceivedEventHandler(subscription)
+	var token _EventRegistrationToken
+	if err := receiver.AddDevToolsProtocolEventReceived(subscription.handler, &token); err != nil {
+		return err
+	}
+	e.devToolsEvents = append(e.devToolsEvents, subscription)
+	return nil
+}
+
+type devToolsEvent struct {
+	handler  *ICoreWebView2DevToolsProtocolEventReceivedEventHandler
+	callback func(params string)
+}
+
+func (d *devToolsEvent) QueryInterface(_, _ uintptr) uintptr {
+	return 0
+}
+
+func (d *devToolsEvent) AddRef() uintptr {
+	return 1
+}
+
+func (d *devToolsEvent) Release() uintptr {
+	return 1
+}
+
+func (d *devToolsEvent) DevToolsProtocolEventReceived(_ *ICoreWebView2, args *ICoreWebView2DevToolsProtocolEventReceivedEventArgs) uintptr {
+	params, err := args.GetParameterObjectAsJson()
+	if err != nil {
+		return 0
+	}
+	d.callback(params)
+	return 0
+}
+
+// SetBounds places the controller at bounds, in client coordinates of the
+// parent window, instead of filling the whole client area on Resize. Passing
+// nil restores the default.
+func (e *Chromium) SetBounds(bounds *w32.Rect) {
+	e.bounds = bounds
+	e.Resize()
+}
+
+func (e *Chromium) controllerBounds() w32.Rect {
+	if e.bounds != nil {
+		return *e.bounds
+	}
+	var bounds w32.Rect
+	_, _, _ = w32.User32GetClientRect.Call(e.hwnd, uintptr(unsafe.Pointer(&bounds)))
+	return bounds
+}
+
 func (e *Chromium) Show() error {
 	return e.controller.PutIsVisible(true)
 }
@@ -169,12 +838,23 @@ func (e *Chromium) Release() uintptr {
 }
 
 func (e *Chromium) EnvironmentCompleted(res uintptr, env *ICoreWebView2Environment) uintptr {
-	if int64(res) < 0 {
-		log.Fatalf("Creating environment failed with %08x", res)
+	if int32(res) < 0 {
+		e.embedFailed(fmt.Errorf("creating the environment failed with HRESULT 0x%08X: %w", uint32(res), windows.Errno(uint32(res))))
+		return 0
 	}
 	_, _, _ = env.vtbl.AddRef.Call(uintptr(unsafe.Pointer(env)))
 	e.environment = env
 
+	if e.CompositionHosting {
+		if env3 := env.GetICoreWebView2Environment3(); env3 != nil {
+			defer env3.Release()
+			if err := env3.CreateCoreWebView2CompositionController(e.hwnd, e.compositionControllerCompleted); err == nil {
+				return 0
+			}
+		}
+		log.Printf("Composition hosting is not available, using a windowed controller")
+	}
+
 	_, _, _ = env.vtbl.CreateCoreWebView2Controller.Call(
 		uintptr(unsafe.Pointer(env)),
 		e.hwnd,
@@ -183,9 +863,29 @@ func (e *Chromium) EnvironmentCompleted(res uintptr, env *ICoreWebView2Environme
 	return 0
 }
 
+func (e *Chromium) CreateCoreWebView2CompositionControllerCompleted(res uintptr, compositionController *ICoreWebView2CompositionController) uintptr {
+	if int32(res) < 0 {
+		e.embedFailed(fmt.Errorf("creating the composition controller failed with HRESULT 0x%08X: %w", uint32(res), windows.Errno(uint32(res))))
+		return 0
+	}
+	compositionController.AddRef()
+	e.compositionController = compositionController
+
+	// The rest of the setup only needs the controller interface.
+	controller := compositionController.GetICoreWebView2Controller()
+	if controller == nil {
+		e.embedFailed(errors.New("the composition controller has no ICoreWebView2Controller"))
+		return 0
+	}
+	r := e.CreateCoreWebView2ControllerCompleted(res, controller)
+	_, _, _ = controller.vtbl.Release.Call(uintptr(unsafe.Pointer(controller)))
+	return r
+}
+
 func (e *Chromium) CreateCoreWebView2ControllerCompleted(res uintptr, controller *ICoreWebView2Controller) uintptr {
-	if int64(res) < 0 {
-		log.Fatalf("Creating controller failed with %08x", res)
+	if int32(res) < 0 {
+		e.embedFailed(fmt.Errorf("creating the controller failed with HRESULT 0x%08X: %w", uint32(res), windows.Errno(uint32(res))))
+		return 0
 	}
 	_, _, _ = controller.vtbl.AddRef.Call(uintptr(unsafe.Pointer(controller)))
 	e.controller = controller
@@ -195,9 +895,6 @@ func (e *Chromium) CreateCoreWebView2ControllerCompleted(res uintptr, controller
 		uintptr(unsafe.Pointer(controller)),
 		uintptr(unsafe.Pointer(&e.webview)),
 	)
-	_, _, _ = e.webview.vtbl.AddRef.Call(
-		uintptr(unsafe.Pointer(e.webview)),
-	)
 	_, _, _ = e.webview.vtbl.AddWebMessageReceived.Call(
 		uintptr(unsafe.Pointer(e.webview)),
 		uintptr(unsafe.Pointer(e.webMessageReceived)),
@@ -208,6 +905,11 @@ func (e *Chromium) CreateCoreWebView2ControllerCompleted(res uintptr, controller
 		uintptr(unsafe.Pointer(e.permissionRequested)),
 		uintptr(unsafe.Pointer(&token)),
 	)
+	_, _, _ = e.webview.vtbl.AddDocumentTitleChanged.Call(
+		uintptr(unsafe.Pointer(e.webview)),
+		uintptr(unsafe.Pointer(e.documentTitleChanged)),
+		uintptr(unsafe.Pointer(&token)),
+	)
 	_, _, _ = e.webview.vtbl.AddWebResourceRequested.Call(
 		uintptr(unsafe.Pointer(e.webview)),
 		uintptr(unsafe.Pointer(e.webResourceRequested)),
@@ -218,9 +920,40 @@ func (e *Chromium) CreateCoreWebView2ControllerCompleted(res uintptr, controller
 		uintptr(unsafe.Pointer(e.navigationCompleted)),
 		uintptr(unsafe.Pointer(&token)),
 	)
+	_, _, _ = e.webview.vtbl.AddNavigationStarting.Call(
+		uintptr(unsafe.Pointer(e.webview)),
+		uintptr(unsafe.Pointer(e.navigationStarting)),
+		uintptr(unsafe.Pointer(&token)),
+	)
 
 	_ = e.controller.AddAcceleratorKeyPressed(e.acceleratorKeyPressed, &token)
 
+	if webview2 := e.webview.GetICoreWebView2_2(); webview2 != nil {
+		_ = webview2.AddWebResourceResponseReceived(e.responseReceived, &token)
+		_ = webview2.AddDOMContentLoaded(e.domContentLoaded, &token)
+		webview2.Release()
+	}
+	if webview4 := e.webview.GetICoreWebView2_4(); webview4 != nil {
+		_ = webview4.AddFrameCreated(e.frameCreated, &token)
+		webview4.Release()
+	}
+	if webview8 := e.webview.GetICoreWebView2_8(); webview8 != nil {
+		_ = webview8.AddIsDocumentPlayingAudioChanged(e.playingAudioChanged, &token)
+		webview8.Release()
+	}
+	if webview11 := e.webview.GetICoreWebView2_11(); webview11 != nil {
+		_ = webview11.AddContextMenuRequested(e.contextMenuRequested, &token)
+		webview11.Release()
+	}
+	if webview12 := e.webview.GetICoreWebView2_12(); webview12 != nil {
+		_ = webview12.AddStatusBarTextChanged(e.statusBarTextChanged, &token)
+		webview12.Release()
+	}
+	if webview15 := e.webview.GetICoreWebView2_15(); webview15 != nil {
+		_ = webview15.AddFaviconChanged(e.faviconChanged, &token)
+		webview15.Release()
+	}
+
 	atomic.StoreUintptr(&e.inited, 1)
 
 	if e.focusOnInit {
@@ -232,13 +965,38 @@ func (e *Chromium) CreateCoreWebView2ControllerCompleted(res uintptr, controller
 
 func (e *Chromium) MessageReceived(sender *ICoreWebView2, args *iCoreWebView2WebMessageReceivedEventArgs) uintptr {
 	var message *uint16
-	_, _, _ = args.vtbl.TryGetWebMessageAsString.Call(
+	hr, _, _ := args.vtbl.TryGetWebMessageAsString.Call(
 		uintptr(unsafe.Pointer(args)),
 		uintptr(unsafe.Pointer(&message)),
 	)
-	if e.MessageCallback != nil {
+	// Messages that aren't strings can't be RPC calls and are only handed to
+	// WebMessageJSONCallback.
+	if e.MessageCallback != nil && int32(hr) >= 0 {
 		e.MessageCallback(w32.Utf16PtrToString(message))
 	}
+	if e.MessageSourceCallback != nil && int32(hr) >= 0 {
+		// The source is the URI of the document that posted the message.
+		var source *uint16
+		_, _, _ = args.vtbl.GetSource.Call(
+			uintptr(unsafe.Pointer(args)),
+			uintptr(unsafe.Pointer(&source)),
+		)
+		e.MessageSourceCallback(w32.Utf16PtrToString(message), w32.Utf16PtrToString(source))
+		if source != nil {
+			windows.CoTaskMemFree(unsafe.Pointer(source))
+		}
+	}
+	if e.WebMessageJSONCallback != nil {
+		var messageJSON *uint16
+		_, _, _ = args.vtbl.GetWebMessageAsJSON.Call(
+			uintptr(unsafe.Pointer(args)),
+			uintptr(unsafe.Pointer(&messageJSON)),
+		)
+		if messageJSON != nil {
+			e.WebMessageJSONCallback(w32.Utf16PtrToString(messageJSON))
+			windows.CoTaskMemFree(unsafe.Pointer(messageJSON))
+		}
+	}
 	_, _, _ = sender.vtbl.PostWebMessageAsString.Call(
 		uintptr(unsafe.Pointer(sender)),
 		uintptr(unsafe.Pointer(message)),
@@ -329,6 +1087,12 @@ func (e *Chromium) GetController() *ICoreWebView2Controller {
 	return e.controller
 }
 
+// SupportsInterface reports whether the webview implements the COM interface
+// iid, e.g. "{0EB34DC9-9F91-41E1-8639-95CD5943906B}" for ICoreWebView2_16.
+func (e *Chromium) SupportsInterface(iid string) bool {
+	return supportsInterface(unsafe.Pointer(e.webview), iid)
+}
+
 func boolToInt(input bool) int {
 	if input {
 		return 1
@@ -343,6 +1107,162 @@ func (e *Chromium) NavigationCompleted(sender *ICoreWebView2, args *ICoreWebView
 	return 0
 }
 
+func (e *Chromium) NavigationStarting(sender *ICoreWebView2, args *ICoreWebView2NavigationStartingEventArgs) uintptr {
+	if e.NavigationStartingCallback != nil {
+		e.NavigationStartingCallback(sender, args)
+	}
+	return 0
+}
+
+func (e *Chromium) DocumentTitleChanged(sender *ICoreWebView2, _ uintptr) uintptr {
+	if e.DocumentTitleChangedCallback == nil {
+		return 0
+	}
+	title, err := sender.GetDocumentTitle()
+	if err != nil {
+		return 0
+	}
+	e.DocumentTitleChangedCallback(title)
+	return 0
+}
+
+// DocumentTitle returns the title of the top-level document.
+func (e *Chromium) DocumentTitle() (string, error) {
+	return e.webview.GetDocumentTitle()
+}
+
+// BrowserProcessID returns the process id of the browser process that hosts
+// the webview.
+func (e *Chromium) BrowserProcessID() (uint32, error) {
+	return e.webview.GetBrowserProcessID()
+}
+
+func (e *Chromium) IsDocumentPlayingAudioChanged(sender *ICoreWebView2, _ uintptr) uintptr {
+	if e.AudioPlaybackChangedCallback == nil {
+		return 0
+	}
+	webview8 := sender.GetICoreWebView2_8()
+	if webview8 == nil {
+		return 0
+	}
+	defer webview8.Release()
+	playing, err := webview8.GetIsDocumentPlayingAudio()
+	if err != nil {
+		return 0
+	}
+	e.AudioPlaybackChangedCallback(playing)
+	return 0
+}
+
+func (e *Chromium) WebResourceResponseReceived(_ *ICoreWebView2, args *ICoreWebView2WebResourceResponseReceivedEventArgs) uintptr {
+	if e.WebResourceResponseReceivedCallback == nil {
+		return 0
+	}
+	request, err := args.GetRequest()
+	if err != nil {
+		return 0
+	}
+	defer request.Release()
+	response, err := args.GetResponse()
+	if err != nil {
+		return 0
+	}
+	defer response.Release()
+	e.WebResourceResponseReceivedCallback(request, response)
+	return 0
+}
+
+func (e *Chromium) ContextMenuRequested(_ *ICoreWebView2, args *ICoreWebView2ContextMenuRequestedEventArgs) uintptr {
+	if e.ContextMenuRequestedCallback != nil {
+		e.ContextMenuRequestedCallback(args)
+	}
+	return 0
+}
+
+func (e *Chromium) FrameCreated(_ *ICoreWebView2, args *ICoreWebView2FrameCreatedEventArgs) uintptr {
+	frame, err := args.GetFrame()
+	if err == nil {
+		e.frames = append(e.frames, frame)
+	}
+	return 0
+}
+
+// Frames returns the iframes of the current document that still exist, in
+// creation order. The frames are owned by the Chromium and must not be
+// released by the caller.
+func (e *Chromium) Frames() []*ICoreWebView2Frame {
+	live := e.frames[:0]
+	for _, frame := range e.frames {
+		if destroyed, err := frame.IsDestroyed(); err != nil || destroyed {
+			frame.Release()
+			continue
+		}
+		live = append(live, frame)
+	}
+	e.frames = live
+	return append([]*ICoreWebView2Frame(nil), live...)
+}
+
+func (e *Chromium) StatusBarTextChanged(sender *ICoreWebView2, _ uintptr) uintptr {
+	if e.StatusBarTextChangedCallback == nil {
+		return 0
+	}
+	webview12 := sender.GetICoreWebView2_12()
+	if webview12 == nil {
+		return 0
+	}
+	defer webview12.Release()
+	text, err := webview12.GetStatusBarText()
+	if err != nil {
+		return 0
+	}
+	e.StatusBarTextChangedCallback(text)
+	return 0
+}
+
+func (e *Chromium) DOMContentLoaded(_ *ICoreWebView2, _ uintptr) uintptr {
+	if e.DOMContentLoadedCallback != nil {
+		e.DOMContentLoadedCallback()
+	}
+	return 0
+}
+
+func (e *Chromium) FaviconChanged(sender *ICoreWebView2, _ uintptr) uintptr {
+	if e.FaviconChangedCallback == nil {
+		return 0
+	}
+	webview15 := sender.GetICoreWebView2_15()
+	if webview15 == nil {
+		return 0
+	}
+	defer webview15.Release()
+	_ = webview15.GetFavicon(COREWEBVIEW2_FAVICON_IMAGE_FORMAT_PNG, e.getFaviconCompleted)
+	return 0
+}
+
+func (e *Chromium) GetFaviconCompleted(errorCode uintptr, faviconStream *IStream) uintptr {
+	if e.FaviconChangedCallback == nil || int32(errorCode) < 0 {
+		return 0
+	}
+	// A page without a favicon completes with an empty stream, which is
+	// reported as a nil icon so the caller can clear what it shows.
+	var icon []byte
+	if faviconStream != nil {
+		data, err := io.ReadAll(faviconStream)
+		if err != nil {
+			log.Printf("Reading favicon failed: %v", err)
+			return 0
+		}
+		icon = data
+	}
+	if len(icon) == 0 {
+		e.FaviconChangedCallback(nil, "")
+		return 0
+	}
+	e.FaviconChangedCallback(icon, "image/png")
+	return 0
+}
+
 func (e *Chromium) NotifyParentWindowPositionChanged() error {
 	//It looks like the wndproc function is called before the controller initialization is complete.
 	//Because of this the controller is nil
@@ -3,17 +3,13 @@
 
 package edge
 
-import (
-	"github.com/mzky/go-webview2/internal/w32"
-	"unsafe"
-)
+import "unsafe"
 
 func (e *Chromium) Resize() {
 	if e.controller == nil {
 		return
 	}
-	var bounds w32.Rect
-	w32.User32GetClientRect.Call(e.hwnd, uintptr(unsafe.Pointer(&bounds)))
+	bounds := e.controllerBounds()
 	e.controller.vtbl.PutBounds.Call(
 		uintptr(unsafe.Pointer(e.controller)),
 		uintptr(bounds.Left),
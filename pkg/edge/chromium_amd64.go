@@ -3,18 +3,13 @@
 
 package edge
 
-import (
-	"unsafe"
-
-	"github.com/mzky/go-webview2/internal/w32"
-)
+import "unsafe"
 
 func (e *Chromium) Resize() {
 	if e.controller == nil {
 		return
 	}
-	var bounds w32.Rect
-	_, _, _ = w32.User32GetClientRect.Call(e.hwnd, uintptr(unsafe.Pointer(&bounds)))
+	bounds := e.controllerBounds()
 	_, _, _ = e.controller.vtbl.PutBounds.Call(
 		uintptr(unsafe.Pointer(e.controller)),
 		uintptr(unsafe.Pointer(&bounds)),
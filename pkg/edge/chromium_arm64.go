@@ -3,19 +3,14 @@
 
 package edge
 
-import (
-	"unsafe"
-
-	"github.com/mzky/go-webview2/internal/w32"
-)
+import "unsafe"
 
 func (e *Chromium) Resize() {
 	if e.controller == nil {
 		return
 	}
 
-	var bounds w32.Rect
-	w32.User32GetClientRect.Call(e.hwnd, uintptr(unsafe.Pointer(&bounds)))
+	bounds := e.controllerBounds()
 
 	words := (*[2]uintptr)(unsafe.Pointer(&bounds))
 	e.controller.vtbl.PutBounds.Call(
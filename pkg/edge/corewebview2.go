@@ -4,6 +4,7 @@
 package edge
 
 import (
+	"io"
 	"log"
 	"runtime"
 	"unsafe"
@@ -58,6 +59,12 @@ func createCoreWebView2EnvironmentWithOptions(browserExecutableFolder, userDataF
 
 // IUnknown
 
+var iidIUnknown = NewGUID("{00000000-0000-0000-C000-000000000046}")
+
+// errNoInterface is the E_NOINTERFACE HRESULT returned by QueryInterface
+// implementations for unsupported interfaces.
+const errNoInterface = 0x80004002
+
 type _IUnknownVtbl struct {
 	QueryInterface ComProc
 	AddRef         ComProc
@@ -138,6 +145,11 @@ type ICoreWebView2 struct {
 	vtbl *iCoreWebView2Vtbl
 }
 
+func (i *ICoreWebView2) Release() uintptr {
+	r, _, _ := i.vtbl.Release.Call(uintptr(unsafe.Pointer(i)))
+	return r
+}
+
 func (i *ICoreWebView2) GetSettings() (*ICoreWebViewSettings, error) {
 	var err error
 	var settings *ICoreWebViewSettings
@@ -151,6 +163,87 @@ func (i *ICoreWebView2) GetSettings() (*ICoreWebViewSettings, error) {
 	return settings, nil
 }
 
+func (i *ICoreWebView2) GetDocumentTitle() (string, error) {
+	var err error
+	// Create *uint16 to hold result
+	var _title *uint16
+	_, _, err = i.vtbl.GetDocumentTitle.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&_title)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return "", err
+	} // Get result and cleanup
+	title := windows.UTF16PtrToString(_title)
+	windows.CoTaskMemFree(unsafe.Pointer(_title))
+	return title, nil
+}
+
+func (i *ICoreWebView2) CallDevToolsProtocolMethod(methodName, parametersAsJson string, handler *ICoreWebView2CallDevToolsProtocolMethodCompletedHandler) error {
+	_methodName, err := windows.UTF16PtrFromString(methodName)
+	if err != nil {
+		return err
+	}
+	_parametersAsJson, err := windows.UTF16PtrFromString(parametersAsJson)
+	if err != nil {
+		return err
+	}
+	_, _, err = i.vtbl.CallDevToolsProtocolMethod.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(_methodName)),
+		uintptr(unsafe.Pointer(_parametersAsJson)),
+		uintptr(unsafe.Pointer(handler)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
+
+func (i *ICoreWebView2) GetDevToolsProtocolEventReceiver(eventName string) (*ICoreWebView2DevToolsProtocolEventReceiver, error) {
+	_eventName, err := windows.UTF16PtrFromString(eventName)
+	if err != nil {
+		return nil, err
+	}
+	var receiver *ICoreWebView2DevToolsProtocolEventReceiver
+	_, _, err = i.vtbl.GetDevToolsProtocolEventReceiver.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(_eventName)),
+		uintptr(unsafe.Pointer(&receiver)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return nil, err
+	}
+	return receiver, nil
+}
+
+func (i *ICoreWebView2) CapturePreview(imageFormat COREWEBVIEW2_CAPTURE_PREVIEW_IMAGE_FORMAT, imageStream *IStream, handler *ICoreWebView2CapturePreviewCompletedHandler) error {
+	var err error
+	_, _, err = i.vtbl.CapturePreview.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(imageFormat),
+		uintptr(unsafe.Pointer(imageStream)),
+		uintptr(unsafe.Pointer(handler)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
+
+func (i *ICoreWebView2) GetBrowserProcessID() (uint32, error) {
+	var err error
+	var pid uint32
+	_, _, err = i.vtbl.GetBrowserProcessID.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&pid)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return 0, err
+	}
+	return pid, nil
+}
+
 // ICoreWebView2Environment
 
 type iCoreWebView2EnvironmentVtbl struct {
@@ -166,18 +259,55 @@ type ICoreWebView2Environment struct {
 	vtbl *iCoreWebView2EnvironmentVtbl
 }
 
+func (e *ICoreWebView2Environment) Release() uintptr {
+	r, _, _ := e.vtbl.Release.Call(uintptr(unsafe.Pointer(e)))
+	return r
+}
+
 func (e *ICoreWebView2Environment) CreateWebResourceResponse(content []byte, statusCode int, reasonPhrase string, headers string) (*ICoreWebView2WebResourceResponse, error) {
-	var err error
-	var stream uintptr
+	var stream *IStream
 
 	if len(content) > 0 {
 		// Create stream for response
-		stream, err = w32.SHCreateMemStream(content)
+		memStream, err := w32.SHCreateMemStream(content)
 		if err != nil {
 			return nil, err
 		}
+		stream = (*IStream)(memStream)
+		// The response takes its own reference to the stream.
+		defer stream.Release()
 	}
 
+	return e.createWebResourceResponse(stream, statusCode, reasonPhrase, headers)
+}
+
+// CreateWebResourceResponseFromReader is like CreateWebResourceResponse but
+// the runtime reads the body from content as it is consumed, so large bodies
+// are never held in memory at once.
+func (e *ICoreWebView2Environment) CreateWebResourceResponseFromReader(content io.Reader, statusCode int, reasonPhrase string, headers string) (*ICoreWebView2WebResourceResponse, error) {
+	stream := NewReaderStream(content)
+	defer stream.Release()
+
+	return e.createWebResourceResponse(stream, statusCode, reasonPhrase, headers)
+}
+
+func (e *ICoreWebView2Environment) GetBrowserVersionString() (string, error) {
+	var err error
+	// Create *uint16 to hold result
+	var _value *uint16
+	_, _, err = e.vtbl.GetBrowserVersionString.Call(
+		uintptr(unsafe.Pointer(e)),
+		uintptr(unsafe.Pointer(&_value)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return "", err
+	} // Get result and cleanup
+	value := windows.UTF16PtrToString(_value)
+	windows.CoTaskMemFree(unsafe.Pointer(_value))
+	return value, nil
+}
+
+func (e *ICoreWebView2Environment) createWebResourceResponse(stream *IStream, statusCode int, reasonPhrase string, headers string) (*ICoreWebView2WebResourceResponse, error) {
 	// Convert string 'uri' to *uint16
 	_reason, err := windows.UTF16PtrFromString(reasonPhrase)
 	if err != nil {
@@ -191,7 +321,7 @@ func (e *ICoreWebView2Environment) CreateWebResourceResponse(content []byte, sta
 	var response *ICoreWebView2WebResourceResponse
 	_, _, err = e.vtbl.CreateWebResourceResponse.Call(
 		uintptr(unsafe.Pointer(e)),
-		stream,
+		uintptr(unsafe.Pointer(stream)),
 		uintptr(statusCode),
 		uintptr(unsafe.Pointer(_reason)),
 		uintptr(unsafe.Pointer(_headers)),
@@ -0,0 +1,58 @@
+package edge
+
+import (
+	"errors"
+	"unsafe"
+)
+
+// ErrFeatureUnavailable matches every FeatureUnavailableError with errors.Is.
+var ErrFeatureUnavailable = errors.New("feature is not supported by the installed runtime")
+
+// FeatureUnavailableError is returned by methods that need a COM interface
+// the installed WebView2 runtime doesn't implement yet.
+type FeatureUnavailableError struct {
+	// Interface is the missing interface, e.g. "ICoreWebView2_16".
+	Interface string
+}
+
+func (e *FeatureUnavailableError) Error() string {
+	return e.Interface + " is not supported by the installed runtime"
+}
+
+func (e *FeatureUnavailableError) Is(target error) bool {
+	return target == ErrFeatureUnavailable
+}
+
+// QueryWebView asks the webview for the interface iid and stores it in
+// result, which must point to a pointer of the interface's type, e.g. a
+// **ICoreWebView2_16. If the runtime doesn't implement it a
+// FeatureUnavailableError naming iface, e.g. "ICoreWebView2_16", is
+// returned. The caller must release the interface.
+func (e *Chromium) QueryWebView(iface, iid string, result unsafe.Pointer) error {
+	return queryInterface(unsafe.Pointer(e.webview), iface, iid, result)
+}
+
+// queryInterface is QueryWebView for any COM object. All getters of the
+// version-gated interfaces, e.g. GetICoreWebView2_16, use it.
+func queryInterface(object unsafe.Pointer, iface, iid string, result unsafe.Pointer) error {
+	unknown := (*struct{ vtbl *_IUnknownVtbl })(object)
+	_, _, _ = unknown.vtbl.QueryInterface.Call(
+		uintptr(object),
+		uintptr(unsafe.Pointer(NewGUID(iid))),
+		uintptr(result))
+	if *(*uintptr)(result) == 0 {
+		return &FeatureUnavailableError{Interface: iface}
+	}
+	return nil
+}
+
+// supportsInterface reports whether the COM object implements the interface
+// iid. The reference taken by the probe is released right away.
+func supportsInterface(object unsafe.Pointer, iid string) bool {
+	var result *struct{ vtbl *_IUnknownVtbl }
+	if queryInterface(object, iid, iid, unsafe.Pointer(&result)) != nil {
+		return false
+	}
+	_, _, _ = result.vtbl.Release.Call(uintptr(unsafe.Pointer(result)))
+	return true
+}
@@ -0,0 +1,133 @@
+package edge
+
+import (
+	"io"
+	"sync"
+	"sync/atomic"
+	"unsafe"
+)
+
+// readerStream is a forward-only IStream implemented in Go on top of an
+// io.Reader. It lets the runtime pull response bodies on demand instead of
+// copying them into a memory stream first.
+type readerStream struct {
+	vtbl     *_IStreamVtbl
+	refs     int32
+	position uint64
+	reader   io.Reader
+}
+
+var (
+	iidISequentialStream = NewGUID("{0c733a30-2a1c-11ce-ade5-00aa0044773d}")
+	iidIStream           = NewGUID("{0000000c-0000-0000-C000-000000000046}")
+
+	// readerStreams keeps streams owned by the runtime reachable until their
+	// last reference is released.
+	readerStreams     = map[*readerStream]struct{}{}
+	readerStreamsSync sync.Mutex
+)
+
+const (
+	errNotImpl         = 0x80004001
+	errFail            = 0x80004005
+	errStgAccessDenied = 0x80030005
+	streamSeekCur      = 1
+	successFalse       = 1
+)
+
+func _IStreamReaderQueryInterface(this *readerStream, refiid *GUID, object *unsafe.Pointer) uintptr {
+	if IsEqualGUID(refiid, iidIUnknown) || IsEqualGUID(refiid, iidISequentialStream) || IsEqualGUID(refiid, iidIStream) {
+		_IStreamReaderAddRef(this)
+		*object = unsafe.Pointer(this)
+		return 0
+	}
+	*object = nil
+	return errNoInterface
+}
+
+func _IStreamReaderAddRef(this *readerStream) uintptr {
+	return uintptr(atomic.AddInt32(&this.refs, 1))
+}
+
+func _IStreamReaderRelease(this *readerStream) uintptr {
+	refs := atomic.AddInt32(&this.refs, -1)
+	if refs == 0 {
+		readerStreamsSync.Lock()
+		delete(readerStreams, this)
+		readerStreamsSync.Unlock()
+		if closer, ok := this.reader.(io.Closer); ok {
+			_ = closer.Close()
+		}
+	}
+	return uintptr(refs)
+}
+
+func _IStreamReaderRead(this *readerStream, pv *byte, cb uint32, pcbRead *uint32) uintptr {
+	n, err := io.ReadFull(this.reader, unsafe.Slice(pv, cb))
+	this.position += uint64(n)
+	if pcbRead != nil {
+		*pcbRead = uint32(n)
+	}
+	switch err {
+	case nil:
+		return 0
+	case io.EOF, io.ErrUnexpectedEOF:
+		return successFalse
+	default:
+		return errFail
+	}
+}
+
+func _IStreamReaderWrite(this *readerStream, pv *byte, cb uint32, pcbWritten *uint32) uintptr {
+	return errStgAccessDenied
+}
+
+func _IStreamReaderCommit(this *readerStream, grfCommitFlags uint32) uintptr {
+	return 0
+}
+
+func _IStreamReaderRevert(this *readerStream) uintptr {
+	return errNotImpl
+}
+
+func _IStreamReaderStat(this *readerStream, pstatstg uintptr, grfStatFlag uint32) uintptr {
+	return errNotImpl
+}
+
+func _IStreamReaderClone(this *readerStream, ppstm **IStream) uintptr {
+	return errNotImpl
+}
+
+var _IStreamReaderFn = _IStreamVtbl{
+	_IUnknownVtbl{
+		NewComProc(_IStreamReaderQueryInterface),
+		NewComProc(_IStreamReaderAddRef),
+		NewComProc(_IStreamReaderRelease),
+	},
+	NewComProc(_IStreamReaderRead),
+	NewComProc(_IStreamReaderWrite),
+	NewComProc(_IStreamReaderSeek),
+	NewComProc(_IStreamReaderSetSize),
+	NewComProc(_IStreamReaderCopyTo),
+	NewComProc(_IStreamReaderCommit),
+	NewComProc(_IStreamReaderRevert),
+	NewComProc(_IStreamReaderLockRegion),
+	NewComProc(_IStreamReaderUnlockRegion),
+	NewComProc(_IStreamReaderStat),
+	NewComProc(_IStreamReaderClone),
+}
+
+// NewReaderStream wraps r in an IStream that reads it sequentially. The
+// returned stream holds one reference owned by the caller. r is closed when
+// the last reference is released if it implements io.Closer.
+func NewReaderStream(r io.Reader) *IStream {
+	stream := &readerStream{
+		vtbl:   &_IStreamReaderFn,
+		refs:   1,
+		reader: r,
+	}
+	readerStreamsSync.Lock()
+	readerStreams[stream] = struct{}{}
+	readerStreamsSync.Unlock()
+	return (*IStream)(unsafe.Pointer(stream))
+}
@@ -0,0 +1,32 @@
+package edge
+
+// The 64-bit arguments are passed on the stack as two 32-bit words, which
+// the callbacks have to declare so that stdcall pops the right size.
+
+// Only a seek that reports the current position is supported, which is all
+// a forward-only stream can answer.
+func _IStreamReaderSeek(this *readerStream, dlibMoveLow, dlibMoveHigh uint32, dwOrigin uint32, plibNewPosition *uint64) uintptr {
+	if dwOrigin != streamSeekCur || dlibMoveLow != 0 || dlibMoveHigh != 0 {
+		return errNotImpl
+	}
+	if plibNewPosition != nil {
+		*plibNewPosition = this.position
+	}
+	return 0
+}
+
+func _IStreamReaderSetSize(this *readerStream, libNewSizeLow, libNewSizeHigh uint32) uintptr {
+	return errNotImpl
+}
+
+func _IStreamReaderCopyTo(this *readerStream, pstm *IStream, cbLow, cbHigh uint32, pcbRead, pcbWritten *uint64) uintptr {
+	return errNotImpl
+}
+
+func _IStreamReaderLockRegion(this *readerStream, libOffsetLow, libOffsetHigh, cbLow, cbHigh uint32, dwLockType uint32) uintptr {
+	return errNotImpl
+}
+
+func _IStreamReaderUnlockRegion(this *readerStream, libOffsetLow, libOffsetHigh, cbLow, cbHigh uint32, dwLockType uint32) uintptr {
+	return errNotImpl
+}
@@ -0,0 +1,29 @@
+package edge
+
+// Only a seek that reports the current position is supported, which is all
+// a forward-only stream can answer.
+func _IStreamReaderSeek(this *readerStream, dlibMove int64, dwOrigin uint32, plibNewPosition *uint64) uintptr {
+	if dwOrigin != streamSeekCur || dlibMove != 0 {
+		return errNotImpl
+	}
+	if plibNewPosition != nil {
+		*plibNewPosition = this.position
+	}
+	return 0
+}
+
+func _IStreamReaderSetSize(this *readerStream, libNewSize uint64) uintptr {
+	return errNotImpl
+}
+
+func _IStreamReaderCopyTo(this *readerStream, pstm *IStream, cb uint64, pcbRead, pcbWritten *uint64) uintptr {
+	return errNotImpl
+}
+
+func _IStreamReaderLockRegion(this *readerStream, libOffset, cb uint64, dwLockType uint32) uintptr {
+	return errNotImpl
+}
+
+func _IStreamReaderUnlockRegion(this *readerStream, libOffset, cb uint64, dwLockType uint32) uintptr {
+	return errNotImpl
+}
@@ -0,0 +1,56 @@
+//go:build windows
+// +build windows
+
+package webview2
+
+import (
+	"unsafe"
+
+	"github.com/lxn/win"
+)
+
+// WindowPlacement is the show state and restored position of a window, as
+// needed to restore a window layout between runs.
+type WindowPlacement struct {
+	State WindowState
+	// Normal is the window rectangle when it is neither minimized nor
+	// maximized, in workspace coordinates.
+	Normal win.RECT
+	// RestoreToMaximized is set for a minimized window that was maximized
+	// before it was minimized.
+	RestoreToMaximized bool
+}
+
+func (w *webview) GetWindowPlacement() WindowPlacement {
+	wp := win.WINDOWPLACEMENT{Length: uint32(unsafe.Sizeof(win.WINDOWPLACEMENT{}))}
+	win.GetWindowPlacement(w.GetHWnd(), &wp)
+	p := WindowPlacement{
+		Normal:             wp.RcNormalPosition,
+		RestoreToMaximized: wp.Flags&win.WPF_RESTORETOMAXIMIZED != 0,
+	}
+	switch wp.ShowCmd {
+	case win.SW_SHOWMAXIMIZED:
+		p.State = WindowStateMaximized
+	case win.SW_SHOWMINIMIZED, win.SW_MINIMIZE, win.SW_SHOWMINNOACTIVE:
+		p.State = WindowStateMinimized
+	}
+	return p
+}
+
+func (w *webview) SetWindowPlacement(p WindowPlacement) {
+	wp := win.WINDOWPLACEMENT{
+		Length:           uint32(unsafe.Sizeof(win.WINDOWPLACEMENT{})),
+		ShowCmd:          win.SW_SHOWNORMAL,
+		RcNormalPosition: p.Normal,
+	}
+	switch p.State {
+	case WindowStateMaximized:
+		wp.ShowCmd = win.SW_SHOWMAXIMIZED
+	case WindowStateMinimized:
+		wp.ShowCmd = win.SW_SHOWMINIMIZED
+		if p.RestoreToMaximized {
+			wp.Flags = win.WPF_RESTORETOMAXIMIZED
+		}
+	}
+	win.SetWindowPlacement(w.GetHWnd(), &wp)
+}
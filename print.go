@@ -0,0 +1,123 @@
+//go:build windows
+// +build windows
+
+package webview2
+
+import (
+	"errors"
+
+	"github.com/mzky/go-webview2/pkg/edge"
+)
+
+// PrintSettings configures a silent print started with PrintWithSettings. The
+// zero value prints one copy with the defaults of the default printer.
+type PrintSettings struct {
+	// PrinterName selects the printer by name, as shown in the Windows
+	// printer settings. Empty means the default printer.
+	PrinterName string
+	Copies      int
+	Landscape   bool
+	// PageRanges selects the pages to print, e.g. "1-3, 5". Empty means all.
+	PageRanges      string
+	Backgrounds     bool
+	HeaderAndFooter bool
+	SelectionOnly   bool
+}
+
+func (w *webview) Print(showDialog bool) error {
+	if !showDialog {
+		return w.PrintWithSettings(PrintSettings{}, nil)
+	}
+	webview16 := w.chromium().GetICoreWebView2_16()
+	if webview16 == nil {
+		return &edge.FeatureUnavailableError{Interface: "ICoreWebView2_16"}
+	}
+	defer webview16.Release()
+	return webview16.ShowPrintUI(edge.COREWEBVIEW2_PRINT_DIALOG_KIND_BROWSER)
+}
+
+func (w *webview) PrintWithSettings(settings PrintSettings, done func(err error)) error {
+	printSettings, err := w.printSettings(settings)
+	if err != nil {
+		return err
+	}
+	if printSettings != nil {
+		defer printSettings.Release()
+	}
+	return w.chromium().Print(printSettings, func(status edge.COREWEBVIEW2_PRINT_STATUS, err error) {
+		if done == nil {
+			return
+		}
+		switch {
+		case err != nil:
+			done(err)
+		case status == edge.COREWEBVIEW2_PRINT_STATUS_PRINTER_UNAVAILABLE:
+			done(errors.New("webview2: printer is not available"))
+		case status != edge.COREWEBVIEW2_PRINT_STATUS_SUCCEEDED:
+			done(errors.New("webview2: printing failed"))
+		default:
+			done(nil)
+		}
+	})
+}
+
+// printSettings converts settings, returning nil for the zero value so that
+// the runtime applies its own defaults.
+func (w *webview) printSettings(settings PrintSettings) (*edge.ICoreWebView2PrintSettings, error) {
+	if settings == (PrintSettings{}) {
+		return nil, nil
+	}
+	environment6 := w.chromium().Environment().GetICoreWebView2Environment6()
+	if environment6 == nil {
+		return nil, &edge.FeatureUnavailableError{Interface: "ICoreWebView2Environment6"}
+	}
+	defer environment6.Release()
+	printSettings, err := environment6.CreatePrintSettings()
+	if err != nil {
+		return nil, err
+	}
+	if err := applyPrintSettings(printSettings, settings); err != nil {
+		printSettings.Release()
+		return nil, err
+	}
+	return printSettings, nil
+}
+
+func applyPrintSettings(printSettings *edge.ICoreWebView2PrintSettings, settings PrintSettings) error {
+	if settings.Landscape {
+		if err := printSettings.PutOrientation(edge.COREWEBVIEW2_PRINT_ORIENTATION_LANDSCAPE); err != nil {
+			return err
+		}
+	}
+	if err := printSettings.PutShouldPrintBackgrounds(settings.Backgrounds); err != nil {
+		return err
+	}
+	if err := printSettings.PutShouldPrintHeaderAndFooter(settings.HeaderAndFooter); err != nil {
+		return err
+	}
+	if err := printSettings.PutShouldPrintSelectionOnly(settings.SelectionOnly); err != nil {
+		return err
+	}
+	if settings.PrinterName == "" && settings.Copies <= 1 && settings.PageRanges == "" {
+		return nil
+	}
+	printSettings2 := printSettings.GetICoreWebView2PrintSettings2()
+	if printSettings2 == nil {
+		return &edge.FeatureUnavailableError{Interface: "ICoreWebView2PrintSettings2"}
+	}
+	defer printSettings2.Release()
+	if settings.PrinterName != "" {
+		if err := printSettings2.PutPrinterName(settings.PrinterName); err != nil {
+			return err
+		}
+	}
+	if settings.Copies > 1 {
+		if err := printSettings2.PutCopies(int32(settings.Copies)); err != nil {
+			return err
+		}
+	}
+	if settings.PageRanges != "" {
+		return printSettings2.PutPageRanges(settings.PageRanges)
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+//go:build windows
+// +build windows
+
+package webview2
+
+import (
+	"strings"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+// webviewProcessName is the executable of all WebView2 runtime processes.
+const webviewProcessName = "msedgewebview2.exe"
+
+// TerminateOrphanedProcesses kills the WebView2 runtime processes started by
+// this process, including their child processes, and returns how many were
+// terminated. The runtime normally shuts them down itself, so this is only a
+// safety net for applications that must not leave processes behind. It must
+// not be called while a webview is still in use.
+func TerminateOrphanedProcesses() (int, error) {
+	targets, err := runtimeProcesses(windows.GetCurrentProcessId())
+	if err != nil {
+		return 0, err
+	}
+
+	var terminated int
+	var firstErr error
+	for _, pid := range targets {
+		process, err := windows.OpenProcess(windows.PROCESS_TERMINATE, false, pid)
+		if err == nil {
+			err = windows.TerminateProcess(process, 1)
+			windows.CloseHandle(process)
+		}
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		terminated++
+	}
+	return terminated, firstErr
+}
+
+func (w *webview) SetBrowserProcessPriority(priority int) error {
+	var pid uint32
+	var err error
+	w.sync(func() {
+		pid, err = w.chromium().BrowserProcessID()
+	})
+	if err != nil {
+		return err
+	}
+	if err := setPriorityClass(pid, uint32(priority)); err != nil {
+		return err
+	}
+	// Renderer and utility processes come and go, so failing to change one
+	// of them is not an error.
+	children, _ := runtimeProcesses(pid)
+	for _, child := range children {
+		_ = setPriorityClass(child, uint32(priority))
+	}
+	return nil
+}
+
+func setPriorityClass(pid, priority uint32) error {
+	process, err := windows.OpenProcess(windows.PROCESS_SET_INFORMATION, false, pid)
+	if err != nil {
+		return err
+	}
+	defer windows.CloseHandle(process)
+	return windows.SetPriorityClass(process, priority)
+}
+
+// runtimeProcesses returns the WebView2 runtime processes started by the
+// process root, directly or through other runtime processes.
+func runtimeProcesses(root uint32) ([]uint32, error) {
+	snapshot, err := windows.CreateToolhelp32Snapshot(windows.TH32CS_SNAPPROCESS, 0)
+	if err != nil {
+		return nil, err
+	}
+	defer windows.CloseHandle(snapshot)
+
+	children := map[uint32][]uint32{}
+	names := map[uint32]string{}
+	entry := windows.ProcessEntry32{Size: uint32(unsafe.Sizeof(windows.ProcessEntry32{}))}
+	for err = windows.Process32First(snapshot, &entry); err == nil; err = windows.Process32Next(snapshot, &entry) {
+		children[entry.ParentProcessID] = append(children[entry.ParentProcessID], entry.ProcessID)
+		names[entry.ProcessID] = windows.UTF16ToString(entry.ExeFile[:])
+	}
+	if err != windows.ERROR_NO_MORE_FILES {
+		return nil, err
+	}
+
+	// Only runtime processes are followed, so that other children of this
+	// process and whatever they started are left alone.
+	var targets []uint32
+	queue := []uint32{root}
+	for len(queue) > 0 {
+		pid := queue[0]
+		queue = queue[1:]
+		for _, child := range children[pid] {
+			if strings.EqualFold(names[child], webviewProcessName) {
+				targets = append(targets, child)
+				queue = append(queue, child)
+			}
+		}
+	}
+	return targets, nil
+}
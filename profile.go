@@ -0,0 +1,61 @@
+//go:build windows
+// +build windows
+
+package webview2
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/mzky/go-webview2/pkg/edge"
+)
+
+// TrackingPreventionLevel is the tracking prevention level of the profile, as
+// in the edge://settings/privacy page.
+type TrackingPreventionLevel int
+
+const (
+	TrackingPreventionOff      TrackingPreventionLevel = edge.COREWEBVIEW2_TRACKING_PREVENTION_LEVEL_NONE
+	TrackingPreventionBasic    TrackingPreventionLevel = edge.COREWEBVIEW2_TRACKING_PREVENTION_LEVEL_BASIC
+	TrackingPreventionBalanced TrackingPreventionLevel = edge.COREWEBVIEW2_TRACKING_PREVENTION_LEVEL_BALANCED
+	TrackingPreventionStrict   TrackingPreventionLevel = edge.COREWEBVIEW2_TRACKING_PREVENTION_LEVEL_STRICT
+)
+
+func (w *webview) Profile() (*edge.ICoreWebView2Profile, error) {
+	return w.chromium().GetProfile()
+}
+
+func (w *webview) SetTrackingPreventionLevel(level TrackingPreventionLevel) error {
+	if level < TrackingPreventionOff || level > TrackingPreventionStrict {
+		return errors.New("webview2: invalid tracking prevention level")
+	}
+	profile, err := w.Profile()
+	if err != nil {
+		return err
+	}
+	defer profile.Release()
+	profile3 := profile.GetICoreWebView2Profile3()
+	if profile3 == nil {
+		return &edge.FeatureUnavailableError{Interface: "ICoreWebView2Profile3"}
+	}
+	defer profile3.Release()
+	return profile3.PutPreferredTrackingPreventionLevel(edge.COREWEBVIEW2_TRACKING_PREVENTION_LEVEL(level))
+}
+
+func (w *webview) SetDefaultDownloadFolder(path string) error {
+	path, err := filepath.Abs(path)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(path, 0755); err != nil {
+		return fmt.Errorf("webview2: download folder %s is not usable: %w", path, err)
+	}
+	profile, err := w.Profile()
+	if err != nil {
+		return err
+	}
+	defer profile.Release()
+	return profile.PutDefaultDownloadFolderPath(path)
+}
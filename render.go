@@ -0,0 +1,113 @@
+//go:build windows
+// +build windows
+
+package webview2
+
+import (
+	"errors"
+	"fmt"
+	"runtime"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/lxn/win"
+	"github.com/mzky/go-webview2/pkg/edge"
+	"golang.org/x/sys/windows"
+)
+
+// RenderToImage loads uri in a webview that is never shown and returns a PNG
+// of its width x height viewport once the page has loaded, e.g. to create
+// thumbnails. The webview runs on its own thread and is destroyed before
+// RenderToImage returns; an error is returned if the page doesn't load
+// within timeout.
+func RenderToImage(uri string, width, height int, timeout time.Duration) ([]byte, error) {
+	type rendered struct {
+		image []byte
+		err   error
+	}
+	results := make(chan rendered, 1)
+	go func() {
+		runtime.LockOSThread()
+		defer runtime.UnlockOSThread()
+
+		// The webview needs a single-threaded apartment, which the edge
+		// package only sets up for the main thread.
+		if err := windows.CoInitializeEx(0, windows.COINIT_APARTMENTTHREADED); err != nil && err != syscall.Errno(windows.S_FALSE) {
+			results <- rendered{nil, fmt.Errorf("webview2: initializing COM: %w", err)}
+			return
+		}
+		defer windows.CoUninitialize()
+
+		// A layered window is invisible until its attributes are set, and
+		// an almost transparent one still counts as visible to the browser,
+		// which stops rendering windows that are hidden or off-screen. It is
+		// click-through, so that it doesn't swallow the user's input.
+		wv, err := NewWithOptions(WebViewOptions{
+			WindowOptions: WindowOptions{
+				Width:   uint(width),
+				Height:  uint(height),
+				Style:   win.WS_POPUP,
+				ExStyle: win.WS_EX_LAYERED | win.WS_EX_TRANSPARENT | win.WS_EX_TOOLWINDOW | win.WS_EX_NOACTIVATE,
+			},
+		})
+		if err != nil {
+			results <- rendered{nil, err}
+			return
+		}
+		w := wv.(*webview)
+		w.SetClickThrough(true)
+		w.SetOpacity(1)
+
+		var once sync.Once
+		finish := func(image []byte, err error) {
+			once.Do(func() {
+				results <- rendered{image, err}
+				// Not torn down from within the completion handler that
+				// may have called finish.
+				w.Dispatch(func() {
+					w.chromium().Close(nil)
+					w.Destroy()
+				})
+			})
+		}
+		timer := time.AfterFunc(timeout, func() {
+			w.Dispatch(func() {
+				finish(nil, errors.New("webview2: RenderToImage timed out"))
+			})
+		})
+		defer timer.Stop()
+
+		loaded := make(chan error, 1)
+		stopped := make(chan struct{})
+		w.startNavigation(uri, loaded)
+		go func() {
+			var err error
+			select {
+			case err = <-loaded:
+			case <-stopped:
+				return
+			}
+			w.Dispatch(func() {
+				if err != nil {
+					finish(nil, err)
+					return
+				}
+				err := w.chromium().CapturePreview(edge.COREWEBVIEW2_CAPTURE_PREVIEW_IMAGE_FORMAT_PNG, finish)
+				if err != nil {
+					finish(nil, err)
+				}
+			})
+		}()
+		w.Run()
+		close(stopped)
+		// Releases the COM objects unless finish already has; the window
+		// context went away with the window.
+		w.chromium().Close(nil)
+		once.Do(func() {
+			results <- rendered{nil, errors.New("webview2: RenderToImage stopped before the page was captured")}
+		})
+	}()
+	r := <-results
+	return r.image, r.err
+}
@@ -0,0 +1,29 @@
+//go:build windows
+// +build windows
+
+package webview2
+
+import "github.com/mzky/go-webview2/pkg/edge"
+
+func (w *webview) SetWebResourceResponseReceivedHandler(fn func(uri string, status int, headers map[string]string)) {
+	if fn == nil {
+		w.chromium().WebResourceResponseReceivedCallback = nil
+		return
+	}
+	w.chromium().WebResourceResponseReceivedCallback = func(request *edge.ICoreWebView2WebResourceRequest, response *edge.ICoreWebView2WebResourceResponseView) {
+		uri, err := request.GetUri()
+		if err != nil {
+			return
+		}
+		status, err := response.GetStatusCode()
+		if err != nil {
+			return
+		}
+		var headers map[string]string
+		if h, err := response.GetHeaders(); err == nil {
+			headers, _ = h.All()
+			h.Release()
+		}
+		fn(uri, status, headers)
+	}
+}
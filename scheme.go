@@ -0,0 +1,92 @@
+//go:build windows
+// +build windows
+
+package webview2
+
+import (
+	"log"
+	"strings"
+	"sync"
+
+	"github.com/mzky/go-webview2/pkg/edge"
+)
+
+// SchemeHandler serves a request for a custom scheme. It returns the response
+// body and its MIME type, or ok == false to answer with 404 Not Found.
+type SchemeHandler func(uri string) (data []byte, mime string, ok bool)
+
+var (
+	customSchemes     = map[string]SchemeHandler{}
+	customSchemesSync sync.RWMutex
+)
+
+// RegisterCustomScheme registers handler for URIs of the form scheme://host/path.
+// Unlike a virtual host mapping the scheme is a real origin of its own and is
+// treated as secure, which matters for CSP and service workers.
+//
+// Schemes are registered with the WebView2 environment when it is created, so
+// this must be called before New or NewWithOptions. The handler runs on the UI
+// thread.
+func RegisterCustomScheme(scheme string, handler SchemeHandler) {
+	scheme = strings.ToLower(strings.TrimSuffix(scheme, "://"))
+	customSchemesSync.Lock()
+	defer customSchemesSync.Unlock()
+	customSchemes[scheme] = handler
+}
+
+func customSchemeRegistrations() []*edge.ICoreWebView2CustomSchemeRegistration {
+	customSchemesSync.RLock()
+	defer customSchemesSync.RUnlock()
+	var registrations []*edge.ICoreWebView2CustomSchemeRegistration
+	for scheme := range customSchemes {
+		registration := edge.NewICoreWebView2CustomSchemeRegistration(scheme)
+		registration.TreatAsSecure = true
+		registration.HasAuthorityComponent = true
+		registrations = append(registrations, registration)
+	}
+	return registrations
+}
+
+func customSchemeHandler(uri string) (SchemeHandler, bool) {
+	i := strings.Index(uri, ":")
+	if i < 0 {
+		return nil, false
+	}
+	customSchemesSync.RLock()
+	defer customSchemesSync.RUnlock()
+	handler, ok := customSchemes[strings.ToLower(uri[:i])]
+	return handler, ok
+}
+
+func (w *webview) webResourceRequested(request *edge.ICoreWebView2WebResourceRequest, args *edge.ICoreWebView2WebResourceRequestedEventArgs) {
+	uri, err := request.GetUri()
+	if err != nil {
+		log.Printf("unable to get request uri: %v", err)
+		return
+	}
+	if w.serveStream(uri, args) {
+		return
+	}
+	if handler, ok := customSchemeHandler(uri); ok {
+		w.serveCustomScheme(handler, uri, args)
+	}
+}
+
+func (w *webview) serveCustomScheme(handler SchemeHandler, uri string, args *edge.ICoreWebView2WebResourceRequestedEventArgs) {
+	env := w.chromium().Environment()
+	var response *edge.ICoreWebView2WebResourceResponse
+	var err error
+	if data, mime, ok := handler(uri); ok {
+		response, err = env.CreateWebResourceResponse(data, 200, "OK", "Content-Type: "+mime)
+	} else {
+		response, err = env.CreateWebResourceResponse(nil, 404, "Not Found", "")
+	}
+	if err != nil {
+		log.Printf("unable to create response for %s: %v", uri, err)
+		return
+	}
+	defer response.Release()
+	if err := args.PutResponse(response); err != nil {
+		log.Printf("unable to set response for %s: %v", uri, err)
+	}
+}
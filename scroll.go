@@ -0,0 +1,41 @@
+//go:build windows
+// +build windows
+
+package webview2
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+)
+
+func (w *webview) GetScrollPosition() (x, y int, err error) {
+	if w.onMainThread() {
+		return 0, 0, errors.New("webview2: GetScrollPosition would block the main thread")
+	}
+	results := make(chan scriptResult, 1)
+	w.Dispatch(func() {
+		err := w.chromium().ExecuteScript("[window.scrollX, window.scrollY]", func(result string, err error) {
+			results <- scriptResult{result, err}
+		})
+		if err != nil {
+			results <- scriptResult{"", err}
+		}
+	})
+	r := <-results
+	if r.err != nil {
+		return 0, 0, r.err
+	}
+	// Scroll offsets are fractional on scaled displays.
+	var pos [2]float64
+	if err := json.Unmarshal([]byte(r.result), &pos); err != nil {
+		return 0, 0, err
+	}
+	return int(pos[0]), int(pos[1]), nil
+}
+
+func (w *webview) ScrollTo(x, y int) {
+	w.Dispatch(func() {
+		w.Eval("window.scrollTo(" + strconv.Itoa(x) + ", " + strconv.Itoa(y) + ")")
+	})
+}
@@ -0,0 +1,53 @@
+//go:build windows
+// +build windows
+
+package webview2
+
+import (
+	"github.com/lxn/win"
+	"github.com/mzky/go-webview2/internal/w32"
+)
+
+// Values of DWMWA_WINDOW_CORNER_PREFERENCE.
+const (
+	cornerDoNotRound = 1
+	cornerRound      = 2 // 8px
+	cornerRoundSmall = 3 // 4px
+)
+
+func (w *webview) SetRoundedCorners(radius int) error {
+	if windowsBuild() >= buildWindows11 {
+		// The DWM only offers two radii, but draws a proper antialiased
+		// border and shadow.
+		preference := uint32(cornerDoNotRound)
+		if radius > 4 {
+			preference = cornerRound
+		} else if radius > 0 {
+			preference = cornerRoundSmall
+		}
+		return w32.DwmSetWindowAttribute(w.hWnd, w32.DWMWAWindowCornerPreference, preference)
+	}
+	w.cornerRadius = radius
+	if radius <= 0 {
+		_, _, _ = w32.User32SetWindowRgn.Call(w.hWnd, 0, 1)
+		return nil
+	}
+	w.updateCornerRegion()
+	return nil
+}
+
+// updateCornerRegion clips the window to a rounded rectangle of its current
+// size, on Windows versions without DWM corner rounding.
+func (w *webview) updateCornerRegion() {
+	rect := &win.RECT{}
+	win.GetWindowRect(w.GetHWnd(), rect)
+	d := uintptr(2 * w.cornerRadius)
+	region, _, _ := w32.Gdi32CreateRoundRectRgn.Call(0, 0, uintptr(rect.Right-rect.Left+1), uintptr(rect.Bottom-rect.Top+1), d, d)
+	// The system owns the region from now on and deletes it.
+	_, _, _ = w32.User32SetWindowRgn.Call(w.hWnd, region, 1)
+}
+
+func (w *webview) SetWindowRegion(region win.HRGN) {
+	w.cornerRadius = 0
+	_, _, _ = w32.User32SetWindowRgn.Call(w.hWnd, uintptr(region), 1)
+}
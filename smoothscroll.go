@@ -0,0 +1,44 @@
+//go:build windows
+// +build windows
+
+package webview2
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// smoothScrollScript adds or removes a style sheet that turns off animated
+// scrolling of anchors and scrollTo calls. Each call to
+// SetSmoothScrollingEnabled replaces the script of the previous one.
+const smoothScrollScript = `(function(enabled) {
+	function apply() {
+		var style = document.getElementById("webview2-smooth-scroll");
+		if (enabled) {
+			if (style) style.remove();
+			return;
+		}
+		if (!style) {
+			style = document.createElement("style");
+			style.id = "webview2-smooth-scroll";
+			style.textContent = "*, html { scroll-behavior: auto !important; }";
+			(document.head || document.documentElement).appendChild(style);
+		}
+	}
+	if (document.readyState === "loading") {
+		document.addEventListener("DOMContentLoaded", apply);
+	} else {
+		apply();
+	}
+})(%s);`
+
+func (w *webview) SetSmoothScrollingEnabled(enabled bool) {
+	if enabled {
+		removeBrowserArgument("disable-smooth-scrolling")
+	} else {
+		setBrowserArgument("disable-smooth-scrolling", "")
+	}
+	script := fmt.Sprintf(smoothScrollScript, strconv.FormatBool(enabled))
+	w.replaceInit("smoothScroll", script)
+	w.Eval(script)
+}
@@ -0,0 +1,89 @@
+//go:build windows
+// +build windows
+
+package webview2
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"io"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/mzky/go-webview2/pkg/edge"
+)
+
+// streamURL is the prefix of the URLs that serve io.Reader results of bound
+// functions. The .invalid TLD never resolves, so these requests can only be
+// answered by the webview itself.
+const streamURL = "https://go-webview2.invalid/stream/"
+
+// streamTimeout is how long a registered stream waits for its fetch. The
+// page fetches the URL as soon as the promise resolves, so a stream that is
+// still unclaimed after this long belongs to a page that went away.
+const streamTimeout = 30 * time.Second
+
+// registerStream makes r available for a single fetch and returns its URL.
+// If the fetch doesn't arrive within streamTimeout, r is dropped and closed
+// if it is an io.Closer.
+func (w *webview) registerStream(r io.Reader) (string, error) {
+	var id [16]byte
+	if _, err := rand.Read(id[:]); err != nil {
+		return "", err
+	}
+	token := hex.EncodeToString(id[:])
+
+	w.m.Lock()
+	if w.streams == nil {
+		w.streams = map[string]io.Reader{}
+	}
+	w.streams[token] = r
+	w.m.Unlock()
+
+	time.AfterFunc(streamTimeout, func() {
+		w.m.Lock()
+		r, ok := w.streams[token]
+		delete(w.streams, token)
+		w.m.Unlock()
+		if c, isCloser := r.(io.Closer); ok && isCloser {
+			_ = c.Close()
+		}
+	})
+	return streamURL + token, nil
+}
+
+// serveStream answers a request for a registered stream. It reports whether
+// uri belonged to the stream URL space.
+func (w *webview) serveStream(uri string, args *edge.ICoreWebView2WebResourceRequestedEventArgs) bool {
+	if !strings.HasPrefix(uri, streamURL) {
+		return false
+	}
+	token := strings.TrimPrefix(uri, streamURL)
+
+	w.m.Lock()
+	r, ok := w.streams[token]
+	delete(w.streams, token)
+	w.m.Unlock()
+
+	env := w.chromium().Environment()
+	var response *edge.ICoreWebView2WebResourceResponse
+	var err error
+	if ok {
+		response, err = env.CreateWebResourceResponseFromReader(r, 200, "OK",
+			"Content-Type: application/octet-stream\nAccess-Control-Allow-Origin: *")
+	} else {
+		response, err = env.CreateWebResourceResponse(nil, 404, "Not Found", "Access-Control-Allow-Origin: *")
+	}
+	if err != nil {
+		log.Printf("unable to create response for %s: %v", uri, err)
+		return true
+	}
+	// PutResponse takes its own reference; releasing ours lets the reader
+	// stream be freed, and its reader closed, once the body has been read.
+	defer response.Release()
+	if err := args.PutResponse(response); err != nil {
+		log.Printf("unable to set response for %s: %v", uri, err)
+	}
+	return true
+}
@@ -0,0 +1,93 @@
+//go:build windows
+// +build windows
+
+package webview2
+
+import (
+	"math"
+	"syscall"
+	"unsafe"
+
+	"github.com/lxn/win"
+	"github.com/mzky/go-webview2/internal/w32"
+	"golang.org/x/sys/windows"
+)
+
+// TaskbarState is the state of the progress bar in the taskbar button.
+type TaskbarState int
+
+const (
+	TaskbarNoProgress    TaskbarState = win.TBPF_NOPROGRESS
+	TaskbarIndeterminate TaskbarState = win.TBPF_INDETERMINATE
+	TaskbarNormal        TaskbarState = win.TBPF_NORMAL
+	TaskbarError         TaskbarState = win.TBPF_ERROR
+	TaskbarPaused        TaskbarState = win.TBPF_PAUSED
+)
+
+func (w *webview) taskbarList() (*win.ITaskbarList3, error) {
+	if w.taskbar != nil {
+		return w.taskbar, nil
+	}
+	var taskbar *win.ITaskbarList3
+	hr := win.CoCreateInstance(&win.CLSID_TaskbarList, nil, win.CLSCTX_ALL, &win.IID_ITaskbarList3, (*unsafe.Pointer)(unsafe.Pointer(&taskbar)))
+	if win.FAILED(hr) {
+		return nil, syscall.Errno(uint32(hr))
+	}
+	r, _, _ := syscall.Syscall(taskbar.LpVtbl.HrInit, 1, uintptr(unsafe.Pointer(taskbar)), 0, 0)
+	if win.FAILED(win.HRESULT(r)) {
+		syscall.Syscall(taskbar.LpVtbl.Release, 1, uintptr(unsafe.Pointer(taskbar)), 0, 0)
+		return nil, syscall.Errno(uint32(r))
+	}
+	w.taskbar = taskbar
+	return taskbar, nil
+}
+
+func (w *webview) releaseTaskbarList() {
+	if w.taskbar == nil {
+		return
+	}
+	syscall.Syscall(w.taskbar.LpVtbl.Release, 1, uintptr(unsafe.Pointer(w.taskbar)), 0, 0)
+	w.taskbar = nil
+}
+
+func (w *webview) SetTaskbarProgress(state TaskbarState, completed, total uint64) error {
+	taskbar, err := w.taskbarList()
+	if err != nil {
+		return err
+	}
+	if hr := taskbar.SetProgressState(w.GetHWnd(), int(state)); win.FAILED(hr) {
+		return syscall.Errno(uint32(hr))
+	}
+	if state == TaskbarNoProgress || state == TaskbarIndeterminate || total == 0 {
+		return nil
+	}
+	// The value is passed on as 32 bits, so large totals are scaled down.
+	for total > math.MaxUint32 {
+		completed >>= 1
+		total >>= 1
+	}
+	if hr := taskbar.SetProgressValue(w.GetHWnd(), uint32(completed), uint32(total)); win.FAILED(hr) {
+		return syscall.Errno(uint32(hr))
+	}
+	return nil
+}
+
+// SetAppUserModelID sets the AppUserModelID of the process, which Windows uses
+// to group its windows on the taskbar and to attribute notifications and jump
+// lists to the application. It must be called before the first window is
+// created.
+func SetAppUserModelID(id string) error {
+	_id, err := windows.UTF16PtrFromString(id)
+	if err != nil {
+		return err
+	}
+	hr, _, _ := w32.Shell32SetCurrentProcessExplicitAppUserModelID.Call(uintptr(unsafe.Pointer(_id)))
+	if int32(hr) < 0 {
+		return syscall.Errno(uint32(hr))
+	}
+	appUserModelID = id
+	return nil
+}
+
+// appUserModelID is the id set by SetAppUserModelID, if any.
+var appUserModelID string
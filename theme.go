@@ -0,0 +1,38 @@
+//go:build windows
+// +build windows
+
+package webview2
+
+import (
+	"golang.org/x/sys/windows"
+	"golang.org/x/sys/windows/registry"
+)
+
+const personalizeKey = `Software\Microsoft\Windows\CurrentVersion\Themes\Personalize`
+
+// systemDarkMode reports whether apps should use the dark theme. A missing
+// AppsUseLightTheme value means light, as on Windows versions before 1809.
+func systemDarkMode() bool {
+	k, err := registry.OpenKey(registry.CURRENT_USER, personalizeKey, registry.QUERY_VALUE)
+	if err != nil {
+		return false
+	}
+	defer k.Close()
+	light, _, err := k.GetIntegerValue("AppsUseLightTheme")
+	return err == nil && light == 0
+}
+
+// settingChanged handles WM_SETTINGCHANGE, whose lParam names the changed
+// setting area; theme switches are broadcast as "ImmersiveColorSet".
+func (w *webview) settingChanged(area *uint16) {
+	if w.themeChanged == nil || area == nil {
+		return
+	}
+	if windows.UTF16PtrToString(area) == "ImmersiveColorSet" {
+		w.themeChanged(systemDarkMode())
+	}
+}
+
+func (w *webview) SetSystemThemeChangedHandler(fn func(isDark bool)) {
+	w.themeChanged = fn
+}
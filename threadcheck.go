@@ -0,0 +1,12 @@
+//go:build windows
+// +build windows
+
+package webview2
+
+// checkThread panics if WebViewOptions.StrictThreadCheck is set and method is
+// called from a thread other than the one that created the webview.
+func (w *webview) checkThread(method string) {
+	if w.strictThread && !w.onMainThread() {
+		panic("webview2: " + method + " called off the main thread, use Dispatch")
+	}
+}
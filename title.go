@@ -0,0 +1,41 @@
+//go:build windows
+// +build windows
+
+package webview2
+
+import "strings"
+
+// maxPageTitle is the number of characters of the document title used by
+// SetTitleFormat. Longer titles are cut and end in an ellipsis.
+const maxPageTitle = 80
+
+func (w *webview) SetTitleFormat(format string) {
+	w.titleFormat = format
+	if format == "" {
+		return
+	}
+	if title, err := w.chromium().DocumentTitle(); err == nil {
+		w.SetTitle(formatTitle(format, title))
+	}
+}
+
+func (w *webview) SetDocumentTitleChangedHandler(fn func(title string)) {
+	w.titleChanged = fn
+}
+
+func (w *webview) documentTitleChanged(title string) {
+	if w.titleFormat != "" {
+		w.SetTitle(formatTitle(w.titleFormat, title))
+	}
+	if w.titleChanged != nil {
+		w.titleChanged(title)
+	}
+}
+
+// formatTitle replaces the first %s in format with the document title.
+func formatTitle(format, title string) string {
+	if r := []rune(title); len(r) > maxPageTitle {
+		title = string(r[:maxPageTitle-1]) + "…"
+	}
+	return strings.Replace(format, "%s", title, 1)
+}
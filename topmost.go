@@ -0,0 +1,38 @@
+//go:build windows
+// +build windows
+
+package webview2
+
+import (
+	"github.com/lxn/win"
+	"github.com/mzky/go-webview2/internal/w32"
+)
+
+// topmostTimerID identifies the timer that drops the temporary topmost
+// state set by BringToFrontTemporarily.
+const topmostTimerID = 4
+
+// topmostRevertDelay is how long the window stays topmost, long enough for
+// it to be shown above everything else before other windows may cover it.
+const topmostRevertDelay = 500 // ms
+
+func (w *webview) BringToFrontTemporarily() {
+	w.Dispatch(func() {
+		hwnd := w.GetHWnd()
+		if win.IsIconic(hwnd) {
+			win.ShowWindow(hwnd, win.SW_RESTORE)
+		}
+		if w.IsAlwaysOnTop() {
+			win.SetForegroundWindow(hwnd)
+			return
+		}
+		win.SetWindowPos(hwnd, win.HWND_TOPMOST, 0, 0, 0, 0, win.SWP_NOMOVE|win.SWP_NOSIZE|win.SWP_SHOWWINDOW)
+		win.SetForegroundWindow(hwnd)
+		_, _, _ = w32.User32SetTimer.Call(w.hWnd, topmostTimerID, topmostRevertDelay, 0)
+	})
+}
+
+func (w *webview) revertTopmost() {
+	_, _, _ = w32.User32KillTimer.Call(w.hWnd, topmostTimerID)
+	win.SetWindowPos(w.GetHWnd(), win.HWND_NOTOPMOST, 0, 0, 0, 0, win.SWP_NOMOVE|win.SWP_NOSIZE|win.SWP_NOACTIVATE)
+}
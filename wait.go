@@ -0,0 +1,54 @@
+//go:build windows
+// +build windows
+
+package webview2
+
+import (
+	"errors"
+	"time"
+)
+
+// waitInterval is how often WaitForSelector queries the document.
+const waitInterval = 100 * time.Millisecond
+
+// ErrWaitTimeout is returned by WaitForSelector when the element did not
+// appear in time.
+var ErrWaitTimeout = errors.New("webview2: timed out waiting for selector")
+
+func (w *webview) WaitForSelector(selector string, timeout time.Duration) error {
+	if w.onMainThread() {
+		return errors.New("webview2: WaitForSelector would block the main thread")
+	}
+	script := "document.querySelector(" + jsString(selector) + ") !== null"
+	deadline := time.NewTimer(timeout)
+	defer deadline.Stop()
+	for {
+		// Buffered, so a result that arrives after the deadline doesn't
+		// block the main thread.
+		results := make(chan scriptResult, 1)
+		w.Dispatch(func() {
+			err := w.chromium().ExecuteScript(script, func(result string, err error) {
+				results <- scriptResult{result, err}
+			})
+			if err != nil {
+				results <- scriptResult{"", err}
+			}
+		})
+		select {
+		case r := <-results:
+			if r.err != nil {
+				return r.err
+			}
+			if r.result == "true" {
+				return nil
+			}
+		case <-deadline.C:
+			return ErrWaitTimeout
+		}
+		select {
+		case <-time.After(waitInterval):
+		case <-deadline.C:
+			return ErrWaitTimeout
+		}
+	}
+}
@@ -12,11 +12,19 @@ import (
 	"github.com/mzky/go-webview2/pkg/edge"
 	"github.com/mzky/go-webview2/webviewloader"
 	"golang.org/x/sys/windows"
+	"io"
+	"io/fs"
 	"log"
+	"net/url"
+	"os"
+	"path/filepath"
 	"reflect"
 	"strconv"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"syscall"
+	"time"
 	"unsafe"
 )
 
@@ -37,10 +45,16 @@ func setWindowContext(wnd uintptr, data interface{}) {
 	windowContext[wnd] = data
 }
 
+func deleteWindowContext(wnd uintptr) {
+	windowContextSync.Lock()
+	defer windowContextSync.Unlock()
+	delete(windowContext, wnd)
+}
+
 type browser interface {
 	Embed(hWnd uintptr) bool
 	Resize()
-	Navigate(url string)
+	Navigate(url string) error
 	NavigateToString(htmlContent string)
 	Init(script string)
 	Eval(script string)
@@ -53,19 +67,111 @@ type webview struct {
 	mainThread uintptr
 	browser    browser
 	autofocus  bool
+	noDialog   bool
+	opacity    uint8
+	clickThru  bool
+	deferSize  bool
+	sizing     bool
+	sizeTimer  bool
 	maxSize    w32.Point
 	minSize    w32.Point
 	m          sync.Mutex
 	bindings   map[string]interface{}
+	streams    map[string]io.Reader
+	notify     func(title, body, icon string)
+	notifyIcon bool
 	dispatcher []func()
+
+	msgHandlers   []func(json.RawMessage)
+	taskbar       *win.ITaskbarList3
+	sessionEnd    func() bool
+	powerEvent    func(event PowerEvent)
+	displayChange func()
+	themeChanged  func(isDark bool)
+	offlineHTML   string
+	liveness      *liveness
+
+	cookieWatchers map[string]*cookieWatcher
+	cookiePoll     time.Duration
+
+	features    *FeatureSet
+	navWaiters  []*navWaiter
+	initScripts map[string]*initScript
+
+	bindingFilter   func(origin string) bool
+	cleanupDataPath bool
+	strictThread    bool
+	int64AsString   bool
+	emitters        map[string]func(emit func(interface{}))
+	serialNavigate  bool
+	embedErr        error
+
+	colorKey     uint32 // COLORREF
+	colorKeyed   bool
+	cornerRadius int
+
+	titleFormat  string
+	titleChanged func(title string)
+
+	allowedOrigin string
+	openExternal  bool
+
+	jsErrorHandler func(message, source string, line int, stack string)
+	jsErrorHooked  bool
+	consoleHandler func(level, message string)
+	consoleHooked  bool
 }
 
+// PowerEvent is a power management event passed to the handler set with
+// SetPowerEventHandler. The values are the PBT_* codes of WM_POWERBROADCAST.
+type PowerEvent int
+
+const (
+	PowerSuspend         PowerEvent = 0x4  // the system is about to suspend
+	PowerResume          PowerEvent = 0x7  // resumed after the user interacted
+	PowerStatusChange    PowerEvent = 0xA  // e.g. switched to battery power
+	PowerResumeAutomatic PowerEvent = 0x12 // resumed, sent on every resume
+)
+
+// WindowState is the show state of a window, see WindowOptions.State and
+// WindowPlacement.
+type WindowState int
+
+const (
+	WindowStateNormal WindowState = iota
+	WindowStateMaximized
+	WindowStateMinimized
+)
+
 type WindowOptions struct {
 	Title  string
 	Width  uint
 	Height uint
 	IconId uint
 	Center bool
+
+	// ToolWindow creates a tool window, which has no taskbar button and is
+	// left out of the Alt+Tab list.
+	ToolWindow bool
+
+	// Style and ExStyle replace the window styles passed to CreateWindowExW
+	// when they are non-zero. Style defaults to WS_OVERLAPPEDWINDOW and
+	// ExStyle to none. The WS_* and WS_EX_* constants of github.com/lxn/win
+	// can be combined, e.g. win.WS_OVERLAPPEDWINDOW &^ win.WS_MAXIMIZEBOX for
+	// a window that can't be maximized, or win.WS_POPUP | win.WS_VISIBLE for
+	// one without a frame.
+	Style   uint32
+	ExStyle uint32
+
+	// State is the state the window is first shown in. Starting maximized
+	// this way avoids the visible resize of a later Maximize call.
+	State WindowState
+
+	// ClassName is the name of the window class, "webview" by default. The
+	// class is registered once per process and shared by all windows that use
+	// the same name. Use a name unique to the application to find its windows
+	// reliably with FindWindowToTopWithClass.
+	ClassName string
 }
 
 type WebViewOptions struct {
@@ -85,57 +191,146 @@ type WebViewOptions struct {
 	WindowOptions WindowOptions
 
 	Webview2AutoInstall bool
+
+	// DisableDialogMessageHandling skips the IsDialogMessage call in the message
+	// loop. IsDialogMessage turns Tab and Enter into dialog navigation, which
+	// breaks keyboard handling in some complex pages. Input method messages
+	// always bypass IsDialogMessage, so it isn't needed for CJK input.
+	DisableDialogMessageHandling bool
+
+	// DeferredResize coalesces the resizes of the WebView2 control while the
+	// user drags the window border. The control is resized at most every
+	// resizeInterval during the drag and once more when it ends, instead of
+	// on every WM_SIZE, which reduces jank on large pages and slow hardware.
+	DeferredResize bool
+
+	// CookiePollInterval is how often the cookies watched with
+	// SetCookieChangedHandler are compared. It defaults to one second.
+	CookiePollInterval time.Duration
+
+	// CleanupDataPathOnClose deletes the user data folder, including
+	// cookies, cache and local storage, when the window is destroyed. Run
+	// returns once the browser process has exited and released its files,
+	// or after at most five seconds.
+	CleanupDataPathOnClose bool
+
+	// StrictThreadCheck makes Eval, Init, Navigate, SetHtml, SetTitle and
+	// SetSize panic when called from a thread other than the one that
+	// created the webview, instead of failing in obscure ways inside
+	// WebView2. Use Dispatch to call them from other goroutines. It is meant
+	// for development builds.
+	StrictThreadCheck bool
+
+	// BindInt64AsString makes bound functions return 64-bit integers (int,
+	// int64, uint and uint64) as decimal strings, also inside slices, arrays
+	// and maps. JavaScript numbers are exact only up to 2^53, so larger
+	// values such as database IDs would otherwise silently change. Struct
+	// fields are not converted; tag them with `json:",string"` instead.
+	BindInt64AsString bool
+
+	// SerializeNavigate makes Navigate queue the navigation on the main
+	// thread and stop the one in flight before starting it, so that two
+	// navigations issued in quick succession can't race each other and the
+	// last one wins. Navigate may then be called from any goroutine.
+	SerializeNavigate bool
 }
 
+// resizeTimerID identifies the timer used by DeferredResize.
+const resizeTimerID = 1
+
+// resizeInterval is how often a deferred resize is applied during a drag.
+const resizeInterval = 50 // ms
+
+// ErrRuntimeNotInstalled is returned by the constructors when no WebView2
+// runtime is installed, so the caller can fall back or show its own UI.
+var ErrRuntimeNotInstalled = errors.New("webview2: WebView2 runtime is not installed")
+
 // New creates a new webview in a new window.
-func New(debug bool) WebView { return NewWithOptions(WebViewOptions{Debug: debug}) }
+func New(debug bool) (WebView, error) { return NewWithOptions(WebViewOptions{Debug: debug}) }
 
 // NewWindow creates a new webview using an existing window.
 //
 // Deprecated: Use NewWithOptions.
-func NewWindow(debug bool, window unsafe.Pointer) WebView {
+func NewWindow(debug bool, window unsafe.Pointer) (WebView, error) {
 	return NewWithOptions(WebViewOptions{Debug: debug, Window: window})
 }
 
 // NewWithOptions creates a new webview using the provided options.
-func NewWithOptions(options WebViewOptions) WebView {
+func NewWithOptions(options WebViewOptions) (WebView, error) {
 	w := &webview{}
 	if options.Webview2AutoInstall {
 		if err := w.Webview2AutoInstall(); err != nil {
-			log.Fatal(err)
+			return nil, err
 		}
 	}
+	version, err := webviewloader.GetInstalledVersion()
+	if err != nil {
+		return nil, err
+	}
+	if version == "" {
+		return nil, ErrRuntimeNotInstalled
+	}
 
 	w.bindings = map[string]interface{}{}
+	w.opacity = 255
 	w.autofocus = options.AutoFocus
+	w.noDialog = options.DisableDialogMessageHandling
+	w.deferSize = options.DeferredResize
+	w.cookiePoll = options.CookiePollInterval
+	w.cleanupDataPath = options.CleanupDataPathOnClose
+	w.strictThread = options.StrictThreadCheck
+	w.int64AsString = options.BindInt64AsString
+	w.serialNavigate = options.SerializeNavigate
+	if w.cookiePoll <= 0 {
+		w.cookiePoll = defaultCookiePollInterval
+	}
 
 	chromium := edge.NewChromium()
-	chromium.MessageCallback = w.msgcb
+	chromium.MessageSourceCallback = w.msgcb
 	chromium.DataPath = options.DataPath
+	chromium.CustomSchemes = customSchemeRegistrations()
+	chromium.AdditionalBrowserArguments = additionalBrowserArguments()
+	chromium.WebResourceRequestedCallback = w.webResourceRequested
+	chromium.NavigationCompletedCallback = w.navigationCompleted
+	chromium.DocumentTitleChangedCallback = w.documentTitleChanged
+	chromium.NavigationStartingCallback = w.navigationStarting
 	chromium.SetPermission(edge.CoreWebView2PermissionKindClipboardRead, edge.CoreWebView2PermissionStateAllow)
 
 	w.browser = chromium
 	w.mainThread, _, _ = w32.Kernel32GetCurrentThreadID.Call()
 	if !w.CreateWithOptions(options.WindowOptions) {
-		return nil
+		if w.hWnd != 0 {
+			// Dropped first, so that WM_DESTROY doesn't post a quit message
+			// to the caller's thread.
+			deleteWindowContext(w.hWnd)
+			_, _, _ = w32.User32DestroyWindow.Call(w.hWnd)
+		}
+		if w.embedErr != nil {
+			return nil, fmt.Errorf("webview2: unable to create the webview: %w", w.embedErr)
+		}
+		return nil, errors.New("webview2: unable to create the webview")
+	}
+	chromium.AddWebResourceRequestedFilter(streamURL+"*", edge.COREWEBVIEW2_WEB_RESOURCE_CONTEXT_ALL)
+	for _, registration := range chromium.CustomSchemes {
+		chromium.AddWebResourceRequestedFilter(registration.SchemeName+":*", edge.COREWEBVIEW2_WEB_RESOURCE_CONTEXT_ALL)
 	}
 
 	settings, err := chromium.GetSettings()
 	if err != nil {
-		log.Fatal(err)
+		return nil, err
 	}
 	// disable context menu
 	err = settings.PutAreDefaultContextMenusEnabled(options.Debug)
 	if err != nil {
-		log.Fatal(err)
+		return nil, err
 	}
 	// disable developer tools
 	err = settings.PutAreDevToolsEnabled(options.Debug)
 	if err != nil {
-		log.Fatal(err)
+		return nil, err
 	}
 
-	return w
+	return w, nil
 }
 
 type rpcMessage struct {
@@ -146,19 +341,60 @@ type rpcMessage struct {
 
 func jsString(v interface{}) string { b, _ := json.Marshal(v); return string(b) }
 
-func (w *webview) msgcb(msg string) {
+// messageOrigin reduces the URI of a document to its scheme and host, e.g.
+// "https://example.com:8080".
+func messageOrigin(source string) string {
+	u, err := url.Parse(source)
+	if err != nil {
+		return ""
+	}
+	return u.Scheme + "://" + u.Host
+}
+
+func (w *webview) msgcb(msg, source string) {
 	d := rpcMessage{}
 	if err := json.Unmarshal([]byte(msg), &d); err != nil {
 		log.Printf("invalid RPC message: %v", err)
 		return
 	}
+	switch d.Method {
+	case jsErrorMethod:
+		w.jsError(d.Params)
+		return
+	case consoleMethod:
+		w.consoleMessage(d.Params)
+		return
+	}
 
 	id := strconv.Itoa(d.ID)
+	w.m.Lock()
+	filter := w.bindingFilter
+	w.m.Unlock()
+	if origin := messageOrigin(source); filter != nil && !filter(origin) {
+		w.Dispatch(func() {
+			w.Eval("window._rpc[" + id + "].reject(" + jsString("binding "+d.Method+" is not allowed from "+origin) + "); window._rpc[" + id + "] = undefined")
+		})
+		return
+	}
+	if w.callEmitter(d.ID, d.Method) {
+		return
+	}
 	if res, err := w.callBinding(d); err != nil {
 		w.Dispatch(func() {
 			w.Eval("window._rpc[" + id + "].reject(" + jsString(err.Error()) + "); window._rpc[" + id + "] = undefined")
 		})
-	} else if b, err := json.Marshal(res); err != nil {
+	} else if r, ok := res.(io.Reader); ok {
+		// Readers are not marshalled: the promise resolves to a fetch
+		// Response that streams the data from a one-shot URL instead.
+		u, err := w.registerStream(r)
+		w.Dispatch(func() {
+			if err != nil {
+				w.Eval("window._rpc[" + id + "].reject(" + jsString(err.Error()) + "); window._rpc[" + id + "] = undefined")
+				return
+			}
+			w.Eval("window._rpc[" + id + "].resolve(fetch(" + jsString(u) + ")); window._rpc[" + id + "] = undefined")
+		})
+	} else if b, err := w.marshalResult(res); err != nil {
 		w.Dispatch(func() {
 			w.Eval("window._rpc[" + id + "].reject(" + jsString(err.Error()) + "); window._rpc[" + id + "] = undefined")
 		})
@@ -239,6 +475,43 @@ func wndProc(hWnd, msg, wp, lp uintptr) uintptr {
 			r, _, _ := w32.User32DefWindowProcW.Call(hWnd, msg, wp, lp)
 			return r
 		case w32.WMSize:
+			if w.cornerRadius > 0 {
+				w.updateCornerRegion()
+			}
+			if !w.deferSize || !w.sizing {
+				w.browser.Resize()
+			} else if !w.sizeTimer {
+				w.sizeTimer = true
+				_, _, _ = w32.User32SetTimer.Call(hWnd, resizeTimerID, resizeInterval, 0)
+			}
+		case w32.WMEnterSizeMove:
+			w.sizing = true
+		case w32.WMExitSizeMove:
+			w.sizing = false
+			if w.sizeTimer {
+				w.sizeTimer = false
+				_, _, _ = w32.User32KillTimer.Call(hWnd, resizeTimerID)
+				w.browser.Resize()
+			}
+		case w32.WMTimer:
+			if wp == livenessTimerID {
+				w.pingPage()
+				break
+			}
+			if wp == cookieTimerID {
+				w.pollCookies()
+				break
+			}
+			if wp == topmostTimerID {
+				w.revertTopmost()
+				break
+			}
+			if wp != resizeTimerID {
+				r, _, _ := w32.User32DefWindowProcW.Call(hWnd, msg, wp, lp)
+				return r
+			}
+			w.sizeTimer = false
+			_, _, _ = w32.User32KillTimer.Call(hWnd, resizeTimerID)
 			w.browser.Resize()
 		case w32.WMActivate:
 			if wp == w32.WAInactive {
@@ -247,10 +520,40 @@ func wndProc(hWnd, msg, wp, lp uintptr) uintptr {
 			if w.autofocus {
 				w.browser.Focus()
 			}
+		case w32.WMQueryEndSession:
+			if w.sessionEnd != nil && !w.sessionEnd() {
+				return 0
+			}
+			return 1
+		case w32.WMEndSession:
+			// Nothing to do: the handler already ran on WM_QUERYENDSESSION
+			// and the process is terminated once this returns.
+		case w32.WMPowerBroadcast:
+			if w.powerEvent != nil {
+				w.powerEvent(PowerEvent(wp))
+			}
+			return 1
+		case w32.WMDisplayChange:
+			_ = w.browser.NotifyParentWindowPositionChanged()
+			if w.displayChange != nil {
+				w.displayChange()
+			}
+		case w32.WMSettingChange:
+			// Reinterpret the lParam as the pointer it holds, without a
+			// uintptr to unsafe.Pointer conversion.
+			w.settingChanged(*(**uint16)(unsafe.Pointer(&lp)))
 		case w32.WMClose:
 			_, _, _ = w32.User32DestroyWindow.Call(hWnd)
+		case w32.WMNCDestroy:
+			// The last message of the window; dropping the context lets the
+			// webview be collected.
+			deleteWindowContext(hWnd)
 		case w32.WMDestroy:
-			w.Terminate()
+			if w.cleanupDataPath {
+				w.removeDataPath(w.Terminate)
+			} else {
+				w.Terminate()
+			}
 		case w32.WMGetMinMaxInfo:
 			lpMmi := (*w32.MinMaxInfo)(unsafe.Pointer(lp))
 			if w.maxSize.X > 0 && w.maxSize.Y > 0 {
@@ -276,6 +579,44 @@ func (w *webview) Create(debug bool, window unsafe.Pointer) bool {
 	return w.CreateWithOptions(WindowOptions{})
 }
 
+// defaultClassName is the window class used when WindowOptions.ClassName is
+// empty.
+const defaultClassName = "webview"
+
+var (
+	// wndProcCallback is shared by all windows because callbacks created by
+	// windows.NewCallback are never released.
+	wndProcCallback = windows.NewCallback(wndProc)
+
+	registeredClasses     = map[string]bool{}
+	registeredClassesSync sync.Mutex
+)
+
+// registerClass registers the window class name once per process. Later
+// windows reuse the registration.
+func registerClass(instance windows.Handle, className *uint16, name string, icon uintptr) error {
+	registeredClassesSync.Lock()
+	defer registeredClassesSync.Unlock()
+	if registeredClasses[name] {
+		return nil
+	}
+
+	wc := w32.WndClassExW{
+		CbSize:        uint32(unsafe.Sizeof(w32.WndClassExW{})),
+		HInstance:     instance,
+		LpszClassName: className,
+		HIcon:         windows.Handle(icon),
+		HIconSm:       windows.Handle(icon),
+		LpfnWndProc:   wndProcCallback,
+	}
+	r, _, err := w32.User32RegisterClassExW.Call(uintptr(unsafe.Pointer(&wc)))
+	if r == 0 && err != windows.ERROR_CLASS_ALREADY_EXISTS {
+		return err
+	}
+	registeredClasses[name] = true
+	return nil
+}
+
 func (w *webview) CreateWithOptions(opts WindowOptions) bool {
 	var wHandle windows.Handle
 	_ = windows.GetModuleHandleEx(0, nil, &wHandle)
@@ -291,16 +632,18 @@ func (w *webview) CreateWithOptions(opts WindowOptions) bool {
 		icon, _, _ = w32.User32LoadImageW.Call(uintptr(wHandle), uintptr(opts.IconId), 1, 0, 0, w32.LR_DEFAULTSIZE|w32.LR_SHARED)
 	}
 
-	className, _ := windows.UTF16PtrFromString("webview")
-	wc := w32.WndClassExW{
-		CbSize:        uint32(unsafe.Sizeof(w32.WndClassExW{})),
-		HInstance:     wHandle,
-		LpszClassName: className,
-		HIcon:         windows.Handle(icon),
-		HIconSm:       windows.Handle(icon),
-		LpfnWndProc:   windows.NewCallback(wndProc),
+	if opts.ClassName == "" {
+		opts.ClassName = defaultClassName
+	}
+	className, err := windows.UTF16PtrFromString(opts.ClassName)
+	if err != nil {
+		log.Printf("invalid window class name: %v", err)
+		return false
+	}
+	if err := registerClass(wHandle, className, opts.ClassName, icon); err != nil {
+		log.Printf("unable to register window class %q: %v", opts.ClassName, err)
+		return false
 	}
-	_, _, _ = w32.User32RegisterClassExW.Call(uintptr(unsafe.Pointer(&wc)))
 
 	windowName, _ := windows.UTF16PtrFromString(opts.Title)
 
@@ -327,11 +670,20 @@ func (w *webview) CreateWithOptions(opts WindowOptions) bool {
 		posY = w32.CW_USEDEFAULT
 	}
 
+	style := uintptr(w32.WSOverlappedWindow)
+	if opts.Style != 0 {
+		style = uintptr(opts.Style)
+	}
+	exStyle := uintptr(opts.ExStyle)
+	if opts.ToolWindow {
+		exStyle |= w32.WSExToolWindow
+	}
+
 	w.hWnd, _, _ = w32.User32CreateWindowExW.Call(
-		0,
+		exStyle,
 		uintptr(unsafe.Pointer(className)),
 		uintptr(unsafe.Pointer(windowName)),
-		0xCF0000, // WS_OVERLAPPEDWINDOW
+		style,
 		uintptr(posX),
 		uintptr(posY),
 		uintptr(windowWidth),
@@ -343,14 +695,30 @@ func (w *webview) CreateWithOptions(opts WindowOptions) bool {
 	)
 	setWindowContext(w.hWnd, w)
 
-	_, _, _ = w32.User32ShowWindow.Call(w.hWnd, w32.SWShow)
+	// The class icon belongs to whichever window registered the class first,
+	// so each window sets its own.
+	_, _, _ = w32.User32SendMessageW.Call(w.hWnd, w32.WMSetIcon, w32.IconSmall, icon)
+	_, _, _ = w32.User32SendMessageW.Call(w.hWnd, w32.WMSetIcon, w32.IconBig, icon)
+
+	show := uintptr(w32.SWShow)
+	switch opts.State {
+	case WindowStateMaximized:
+		show = w32.SWSHOWMAXIMIZED
+	case WindowStateMinimized:
+		show = w32.SWSHOWMINIMIZED
+	}
+	_, _, _ = w32.User32ShowWindow.Call(w.hWnd, show)
 	_, _, _ = w32.User32UpdateWindow.Call(w.hWnd)
 	_, _, _ = w32.User32SetFocus.Call(w.hWnd)
 
 	_, _, _ = w32.User32UpdateWindow.Call(w.hWnd)
 	_, _, _ = w32.User32SetFocus.Call(w.hWnd)
 
-	if !w.browser.Embed(w.hWnd) {
+	if chromium, ok := w.browser.(*edge.Chromium); ok {
+		if w.embedErr = chromium.EmbedE(w.hWnd); w.embedErr != nil {
+			return false
+		}
+	} else if !w.browser.Embed(w.hWnd) {
 		return false
 	}
 	w.browser.Resize()
@@ -358,7 +726,13 @@ func (w *webview) CreateWithOptions(opts WindowOptions) bool {
 }
 
 func (w *webview) Destroy() {
+	w.removeNotifyIcon()
+	w.releaseTaskbarList()
 	_, _, _ = w32.User32DestroyWindow.Call(w.hWnd)
+	if w.cleanupDataPath {
+		// WM_DESTROY quits once the user data folder has been removed.
+		return
+	}
 	_, _, _ = w32.User32PostQuitMessage.Call(0)
 }
 
@@ -369,12 +743,19 @@ func (w *webview) Run() {
 func (w *webview) Start(callback func()) {
 	var msg w32.Msg
 	for {
-		_, _, _ = w32.User32GetMessageW.Call(
+		r, _, err := w32.User32GetMessageW.Call(
 			uintptr(unsafe.Pointer(&msg)),
 			0,
 			0,
 			0,
 		)
+		// GetMessage returns -1 on failure and keeps failing, so looping on
+		// would only spin.
+		if int32(r) == -1 {
+			log.Printf("webview2: GetMessage failed: %v", err)
+			callback()
+			return
+		}
 		if msg.Message == w32.WMApp {
 			w.m.Lock()
 			q := append([]func(){}, w.dispatcher...)
@@ -387,10 +768,12 @@ func (w *webview) Start(callback func()) {
 			callback()
 			return
 		}
-		r, _, _ := w32.User32GetAncestor.Call(uintptr(msg.Hwnd), w32.GARoot)
-		r, _, _ = w32.User32IsDialogMessage.Call(r, uintptr(unsafe.Pointer(&msg)))
-		if r != 0 {
-			continue
+		if !w.noDialog && !isIMEMessage(&msg) {
+			r, _, _ := w32.User32GetAncestor.Call(uintptr(msg.Hwnd), w32.GARoot)
+			r, _, _ = w32.User32IsDialogMessage.Call(r, uintptr(unsafe.Pointer(&msg)))
+			if r != 0 {
+				continue
+			}
 		}
 		_, _, _ = w32.User32TranslateMessage.Call(uintptr(unsafe.Pointer(&msg)))
 		_, _, _ = w32.User32DispatchMessageW.Call(uintptr(unsafe.Pointer(&msg)))
@@ -398,6 +781,7 @@ func (w *webview) Start(callback func()) {
 }
 
 func (w *webview) SetHtml(html string) {
+	w.checkThread("SetHtml")
 	w.browser.NavigateToString(html)
 }
 
@@ -410,10 +794,58 @@ func (w *webview) Window() unsafe.Pointer {
 }
 
 func (w *webview) Navigate(url string) {
+	if w.serialNavigate {
+		w.Dispatch(func() {
+			w.chromium().Stop()
+			w.browser.Navigate(url)
+		})
+		return
+	}
+	w.checkThread("Navigate")
 	w.browser.Navigate(url)
 }
 
+func (w *webview) NavigateFile(path string) error {
+	uri, err := fileURL(path)
+	if err != nil {
+		return err
+	}
+	w.browser.Navigate(uri)
+	return nil
+}
+
+// fileURL converts an absolute local path into a file:/// URL, escaping
+// characters such as spaces. UNC paths keep their server as the URL host.
+func fileURL(path string) (string, error) {
+	if !filepath.IsAbs(path) {
+		return "", fmt.Errorf("path is not absolute: %s", path)
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return "", err
+	}
+	if info.IsDir() {
+		return "", fmt.Errorf("path is a directory: %s", path)
+	}
+
+	u := url.URL{Scheme: "file"}
+	p := filepath.ToSlash(filepath.Clean(path))
+	if strings.HasPrefix(p, "//") {
+		// \\server\share\file.html -> file://server/share/file.html
+		p = strings.TrimPrefix(p, "//")
+		i := strings.Index(p, "/")
+		if i < 0 {
+			return "", fmt.Errorf("invalid UNC path: %s", path)
+		}
+		u.Host, u.Path = p[:i], p[i:]
+	} else {
+		u.Path = "/" + p
+	}
+	return u.String(), nil
+}
+
 func (w *webview) SetTitle(title string) {
+	w.checkThread("SetTitle")
 	_title, err := windows.UTF16FromString(title)
 	if err != nil {
 		_title, _ = windows.UTF16FromString("")
@@ -423,6 +855,7 @@ func (w *webview) SetTitle(title string) {
 
 // SetSize 这个方法有点复杂，可以参考win.SetWindowPos方法
 func (w *webview) SetSize(width int, height int, hints Hint) {
+	w.checkThread("SetSize")
 	index := w32.GWLStyle
 	style, _, _ := w32.User32GetWindowLongPtrW.Call(w.hWnd, uintptr(index))
 	if hints == HintFixed {
@@ -452,18 +885,244 @@ func (w *webview) SetSize(width int, height int, hints Hint) {
 	}
 }
 
+func (w *webview) SetSessionEndHandler(fn func() bool) {
+	w.sessionEnd = fn
+}
+
+func (w *webview) SetPowerEventHandler(fn func(event PowerEvent)) {
+	w.powerEvent = fn
+}
+
+func (w *webview) SetDisplayChangeHandler(fn func()) {
+	w.displayChange = fn
+}
+
+func (w *webview) SetResizable(resizable bool) {
+	index := w32.GWLStyle
+	style, _, _ := w32.User32GetWindowLongPtrW.Call(w.hWnd, uintptr(index))
+	if resizable {
+		style |= w32.WSThickFrame | w32.WSMaximizeBox
+	} else {
+		style &^= w32.WSThickFrame | w32.WSMaximizeBox
+	}
+	_, _, _ = w32.User32SetWindowLongPtrW.Call(w.hWnd, uintptr(index), style)
+	_, _, _ = w32.User32SetWindowPos.Call(
+		w.hWnd, 0, 0, 0, 0, 0,
+		w32.SWPNoZOrder|w32.SWPNoActivate|w32.SWPNoMove|w32.SWPNoSize|w32.SWPFrameChanged)
+}
+
+func (w *webview) UserDataFolder() string {
+	return w.chromium().UserDataFolder()
+}
+
+func (w *webview) RuntimeVersion() string {
+	return w.chromium().BrowserVersion()
+}
+
+func (w *webview) SetControllerVisible(visible bool) error {
+	if visible {
+		return w.chromium().Show()
+	}
+	return w.chromium().Hide()
+}
+
+func (w *webview) SetControllerBounds(x, y, width, height int) {
+	if width <= 0 || height <= 0 {
+		w.chromium().SetBounds(nil)
+		return
+	}
+	w.chromium().SetBounds(&w32.Rect{
+		Left:   int32(x),
+		Top:    int32(y),
+		Right:  int32(x + width),
+		Bottom: int32(y + height),
+	})
+}
+
+func (w *webview) SetPasswordAutosaveEnabled(enabled bool) error {
+	settings, err := w.chromium().GetSettings()
+	if err != nil {
+		return err
+	}
+	if !settings.Supports("{cb56846c-4168-4d53-b04f-03b6d6796ff2}") {
+		return &edge.FeatureUnavailableError{Interface: "ICoreWebView2Settings4"}
+	}
+	return settings.PutIsPasswordAutosaveEnabled(enabled)
+}
+
+func (w *webview) SetGeneralAutofillEnabled(enabled bool) error {
+	settings, err := w.chromium().GetSettings()
+	if err != nil {
+		return err
+	}
+	if !settings.Supports("{cb56846c-4168-4d53-b04f-03b6d6796ff2}") {
+		return &edge.FeatureUnavailableError{Interface: "ICoreWebView2Settings4"}
+	}
+	return settings.PutIsGeneralAutofillEnabled(enabled)
+}
+
+func (w *webview) SetScriptEnabled(enabled bool) error {
+	settings, err := w.chromium().GetSettings()
+	if err != nil {
+		return err
+	}
+	return settings.PutIsScriptEnabled(enabled)
+}
+
+func (w *webview) SetWebMessageEnabled(enabled bool) error {
+	settings, err := w.chromium().GetSettings()
+	if err != nil {
+		return err
+	}
+	return settings.PutIsWebMessageEnabled(enabled)
+}
+
+func (w *webview) SetHostObjectsAllowed(allowed bool) error {
+	settings, err := w.chromium().GetSettings()
+	if err != nil {
+		return err
+	}
+	return settings.PutAreHostObjectsAllowed(allowed)
+}
+
+func (w *webview) SetZoomControlEnabled(enabled bool) error {
+	settings, err := w.chromium().GetSettings()
+	if err != nil {
+		return err
+	}
+	return settings.PutIsZoomControlEnabled(enabled)
+}
+
+func (w *webview) SetPinchZoomEnabled(enabled bool) error {
+	settings, err := w.chromium().GetSettings()
+	if err != nil {
+		return err
+	}
+	if !settings.Supports("{183E7052-1D03-43A0-AB99-98E043B66B39}") {
+		return &edge.FeatureUnavailableError{Interface: "ICoreWebView2Settings5"}
+	}
+	return settings.PutIsPinchZoomEnabled(enabled)
+}
+
+func (w *webview) SetSwipeNavigationEnabled(enabled bool) error {
+	settings, err := w.chromium().GetSettings()
+	if err != nil {
+		return err
+	}
+	if !settings.Supports("{11CB3ACD-9BC8-43B8-83BF-F40753714F87}") {
+		return &edge.FeatureUnavailableError{Interface: "ICoreWebView2Settings6"}
+	}
+	return settings.PutIsSwipeNavigationEnabled(enabled)
+}
+
+func (w *webview) SetFaviconChangedHandler(fn func(iconBytes []byte, mime string)) {
+	w.chromium().FaviconChangedCallback = fn
+}
+
+func (w *webview) SetDOMContentLoadedHandler(fn func()) {
+	w.chromium().DOMContentLoadedCallback = fn
+}
+
+func (w *webview) SetStatusBarTextChangedHandler(fn func(text string)) {
+	w.chromium().StatusBarTextChangedCallback = fn
+	// The page is expected to draw the text itself, so the built-in status
+	// bar is only shown while no handler is set.
+	if settings, err := w.chromium().GetSettings(); err == nil {
+		_ = settings.PutIsStatusBarEnabled(fn == nil)
+	}
+}
+
 func (w *webview) Init(js string) {
+	w.checkThread("Init")
 	w.browser.Init(js)
 }
 
+func (w *webview) InitFromFile(path string) error {
+	js, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("webview2: reading init script: %w", err)
+	}
+	w.Init(string(js))
+	return nil
+}
+
+func (w *webview) InitFromFS(fsys fs.FS, name string) error {
+	js, err := fs.ReadFile(fsys, name)
+	if err != nil {
+		return fmt.Errorf("webview2: reading init script: %w", err)
+	}
+	w.Init(string(js))
+	return nil
+}
+
 func (w *webview) Eval(js string) {
+	w.checkThread("Eval")
 	w.browser.Eval(js)
 }
 
+// evalSeq numbers EvalAsync calls, so that a script that never ran can be
+// told apart from one that evaluated to null.
+var evalSeq uint64
+
+func (w *webview) EvalAsync(js string, cb func(result string, err error)) {
+	if cb == nil {
+		cb = func(string, error) {}
+	}
+	// ExecuteScript reports a thrown exception as a plain null result. The
+	// script runs inside a try statement, which keeps the completion value
+	// of its last expression, and the catch block completes with a marker
+	// object instead. Going through eval would fail on pages whose CSP lacks
+	// 'unsafe-eval'.
+	seq := strconv.FormatUint(atomic.AddUint64(&evalSeq, 1), 10)
+	wrapped := "try {\n" + js + "\n} catch (e) { ({__webview2EvalError: String(e)}); } " +
+		"finally { window.__webview2EvalRan = " + seq + "; }"
+	w.Dispatch(func() {
+		err := w.chromium().ExecuteScript(wrapped, func(result string, err error) {
+			if err != nil {
+				cb("", err)
+				return
+			}
+			var r struct {
+				Error *string `json:"__webview2EvalError"`
+			}
+			if json.Unmarshal([]byte(result), &r) == nil && r.Error != nil {
+				cb("", errors.New("script error: "+*r.Error))
+				return
+			}
+			if result != "null" {
+				cb(result, nil)
+				return
+			}
+			// A script that doesn't parse yields null as well, without
+			// running the finally block.
+			err = w.chromium().ExecuteScript("window.__webview2EvalRan === "+seq, func(ran string, err error) {
+				switch {
+				case err != nil:
+					cb("", err)
+				case ran != "true":
+					cb("", errors.New("script error: the script did not run"))
+				default:
+					cb("null", nil)
+				}
+			})
+			if err != nil {
+				cb("", err)
+			}
+		})
+		if err != nil {
+			cb("", err)
+		}
+	})
+}
+
 func (w *webview) GetBrowser() browser {
 	return w.browser
 }
 
+func (w *webview) chromium() *edge.Chromium {
+	return w.browser.(*edge.Chromium)
+}
+
 func (w *webview) Dispatch(f func()) {
 	w.m.Lock()
 	w.dispatcher = append(w.dispatcher, f)
@@ -471,6 +1130,12 @@ func (w *webview) Dispatch(f func()) {
 	_, _, _ = w32.User32PostThreadMessageW.Call(w.mainThread, w32.WMApp, 0, 0)
 }
 
+func (w *webview) SetBindingOriginFilter(fn func(origin string) bool) {
+	w.m.Lock()
+	w.bindingFilter = fn
+	w.m.Unlock()
+}
+
 func (w *webview) Bind(name string, f interface{}) error {
 	v := reflect.ValueOf(f)
 	if v.Kind() != reflect.Func {
@@ -540,11 +1205,24 @@ func LockMutex(name string) error {
 	return nil
 }
 
+// IsElevated reports whether the process runs with an elevated token. WebView2
+// can't share a user data folder between elevated and non-elevated instances,
+// so elevated processes should use a DataPath of their own.
+func IsElevated() bool {
+	return windows.GetCurrentProcessToken().IsElevated()
+}
+
 // FindWindowToTop 查找窗口并显示到最上层，参数为窗口标题，可能需要禁用自动窗口标题，DisableAutoTitle()后SetWindowTitle(windowTitle)
 // 调用此方法前，要重置当前Title，否则查找的焦点优先为自身，w.SetTitle("注销") // 必须，否则焦点会是自己，而不是最先打开的客户端
 func FindWindowToTop(windowTitle string) webview {
+	return FindWindowToTopWithClass(defaultClassName, windowTitle)
+}
+
+// FindWindowToTopWithClass 与FindWindowToTop相同，但只查找窗口类名为className的窗口，
+// 对应创建时的WindowOptions.ClassName，避免找到同样使用本库的其它程序的窗口
+func FindWindowToTopWithClass(className, windowTitle string) webview {
 	wv := webview{}
-	wv.hWnd = uintptr(win.FindWindow(_TEXT("webview"), _TEXT(windowTitle)))
+	wv.hWnd = uintptr(win.FindWindow(_TEXT(className), _TEXT(windowTitle)))
 	wv.RestoreWindow()
 	wv.MoveToCenter()
 	wv.MostTop(true)
@@ -571,6 +1249,98 @@ func (w *webview) MostTop(isTop bool) {
 	}
 }
 
+func (w *webview) SetAlwaysOnTop(onTop bool) {
+	insertAfter := win.HWND_NOTOPMOST
+	if onTop {
+		insertAfter = win.HWND_TOPMOST
+	}
+	win.SetWindowPos(w.GetHWnd(), insertAfter, 0, 0, 0, 0, win.SWP_NOMOVE|win.SWP_NOSIZE|win.SWP_NOACTIVATE)
+}
+
+func (w *webview) IsAlwaysOnTop() bool {
+	return win.GetWindowLong(w.GetHWnd(), win.GWL_EXSTYLE)&win.WS_EX_TOPMOST != 0
+}
+
+func (w *webview) FlashWindow(count int) {
+	info := w32.FlashWInfo{
+		Hwnd:    w.hWnd,
+		DwFlags: w32.FlashWTray,
+		UCount:  uint32(count),
+	}
+	if count <= 0 {
+		info.DwFlags |= w32.FlashWTimerNoFG
+		info.UCount = 0
+	}
+	info.CbSize = uint32(unsafe.Sizeof(info))
+	_, _, _ = w32.User32FlashWindowEx.Call(uintptr(unsafe.Pointer(&info)))
+}
+
+func (w *webview) SetClickThrough(enabled bool) {
+	w.clickThru = enabled
+	w.updateLayered()
+}
+
+func (w *webview) SetTransparentColorKey(r, g, b uint8) {
+	w.colorKey = uint32(r) | uint32(g)<<8 | uint32(b)<<16
+	w.colorKeyed = true
+	w.updateLayered()
+}
+
+func (w *webview) ClearTransparentColorKey() {
+	w.colorKeyed = false
+	w.updateLayered()
+}
+
+func (w *webview) SetOpacity(alpha uint8) {
+	w.opacity = alpha
+	w.updateLayered()
+}
+
+// updateLayered toggles WS_EX_LAYERED depending on whether any of the layered
+// window attributes are in use and applies them.
+func (w *webview) updateLayered() {
+	index := w32.GWLExStyle
+	exStyle, _, _ := w32.User32GetWindowLongPtrW.Call(w.hWnd, uintptr(index))
+	// A click-through window must be layered as well, otherwise
+	// WS_EX_TRANSPARENT only affects the painting order of siblings.
+	layered := w.opacity < 255 || w.clickThru || w.colorKeyed
+	if layered {
+		exStyle |= w32.WSExLayered
+	} else {
+		exStyle &^= w32.WSExLayered
+	}
+	if w.clickThru {
+		exStyle |= w32.WSExTransparent
+	} else {
+		exStyle &^= w32.WSExTransparent
+	}
+	_, _, _ = w32.User32SetWindowLongPtrW.Call(w.hWnd, uintptr(index), exStyle)
+	if layered {
+		flags := uintptr(w32.LWAAlpha)
+		if w.colorKeyed {
+			flags |= w32.LWAColorKey
+		}
+		_, _, _ = w32.User32SetLayeredWindowAttributes.Call(w.hWnd, uintptr(w.colorKey), uintptr(w.opacity), flags)
+	}
+}
+
+func (w *webview) SetBackgroundColor(r, g, b, a uint8) error {
+	controller2 := w.chromium().GetController().GetICoreWebView2Controller2()
+	if controller2 == nil {
+		return &edge.FeatureUnavailableError{Interface: "ICoreWebView2Controller2"}
+	}
+	return controller2.PutDefaultBackgroundColor(edge.COREWEBVIEW2_COLOR{A: a, R: r, G: g, B: b})
+}
+
+func (w *webview) SetAllowExternalDrop(allow bool) error {
+	controller4 := w.chromium().GetController().GetICoreWebView2Controller4()
+	if controller4 == nil {
+		return &edge.FeatureUnavailableError{Interface: "ICoreWebView2Controller4"}
+	}
+	defer controller4.Release()
+	return controller4.PutAllowExternalDrop(allow)
+}
+
 // RestoreWindow 还原窗口（一般为最小化后执行此方法还原窗口）
 func (w *webview) RestoreWindow() {
 	win.ShowWindow(w.GetHWnd(), win.SW_RESTORE)
@@ -1,7 +1,9 @@
 package webviewloader
 
 import (
+	"context"
 	_ "embed"
+	"errors"
 	"fmt"
 	"github.com/jchv/go-winloader"
 	"golang.org/x/sys/windows/registry"
@@ -12,6 +14,7 @@ import (
 	"runtime"
 	"sync"
 	"syscall"
+	"time"
 	"unsafe"
 
 	"golang.org/x/sys/windows"
@@ -54,7 +57,7 @@ func CompareBrowserVersions(v1 string, v2 string) (int, error) {
 	if nativeErr != nil {
 		err = loadFromMemory(nativeErr)
 		if err != nil {
-			return 0, fmt.Errorf("Unable to load WebView2Loader.dll from disk: %v -- or from memory: %w", nativeErr, memErr)
+			return 0, err
 		}
 		_, _, err = memCompareBrowserVersions.Call(
 			uint64(uintptr(unsafe.Pointer(_v1))),
@@ -83,7 +86,7 @@ func GetInstalledVersion() (string, error) {
 	var result *uint16
 	if nativeErr != nil {
 		if err := loadFromMemory(nativeErr); err != nil {
-			return "", fmt.Errorf("Unable to load WebView2Loader.dll from disk: %v -- or from memory: %w", nativeErr, memErr)
+			return "", err
 		}
 		hr64, _, _ := memGetAvailableCoreWebView2BrowserVersionString.Call(
 			uint64(uintptr(unsafe.Pointer(nil))),
@@ -124,7 +127,7 @@ func CreateCoreWebView2EnvironmentWithOptions(browserExecutableFolder, userDataF
 			uint64(environmentOptions),
 			uint64(environmentCompletedHandle),
 		)
-		return uintptr(res), nil
+		return uintptr(res), hresultError("CreateCoreWebView2EnvironmentWithOptions", uintptr(res))
 	}
 	res, _, _ := nativeCreate.Call(
 		uintptr(unsafe.Pointer(browserExecutableFolder)),
@@ -132,23 +135,62 @@ func CreateCoreWebView2EnvironmentWithOptions(browserExecutableFolder, userDataF
 		environmentOptions,
 		environmentCompletedHandle,
 	)
-	return res, nil
+	return res, hresultError("CreateCoreWebView2EnvironmentWithOptions", res)
+}
+
+// hresultError returns an error for a failing HRESULT returned by the loader
+// function name, or nil if hr indicates success. HRESULTs are 32 bits wide
+// regardless of the native register size.
+func hresultError(name string, hr uintptr) error {
+	if int32(hr) >= 0 {
+		return nil
+	}
+	return fmt.Errorf("%s returned HRESULT 0x%X", name, uint32(hr))
 }
 
 func loadFromMemory(nativeErr error) error {
-	var err error
+	if isDiskOnly() {
+		return fmt.Errorf("%w: %v", ErrLoaderNotFound, nativeErr)
+	}
 	// DLL is not available natively. Try loading embedded copy.
 	memOnce.Do(func() {
 		memModule, memErr = winloader.LoadFromMemory(WebView2Loader)
 		if memErr != nil {
-			err = fmt.Errorf("Unable to load WebView2Loader.dll from disk: %v -- or from memory: %w", nativeErr, memErr)
 			return
 		}
 		memCreate = memModule.Proc("CreateCoreWebView2EnvironmentWithOptions")
 		memCompareBrowserVersions = memModule.Proc("CompareBrowserVersions")
 		memGetAvailableCoreWebView2BrowserVersionString = memModule.Proc("GetAvailableCoreWebView2BrowserVersionString")
 	})
-	return err
+	if memErr != nil {
+		return fmt.Errorf("Unable to load WebView2Loader.dll from disk: %v -- or from memory: %w", nativeErr, memErr)
+	}
+	return nil
+}
+
+var (
+	diskOnly     bool
+	diskOnlySync sync.Mutex
+)
+
+// ErrLoaderNotFound is returned when WebView2Loader.dll cannot be loaded from
+// disk and SetDiskOnly disabled the embedded copy.
+var ErrLoaderNotFound = errors.New("webviewloader: WebView2Loader.dll not found on disk")
+
+// SetDiskOnly disables the fallback that loads the embedded WebView2Loader.dll
+// from memory when no copy is found on disk. Some security software flags
+// that technique; with diskOnly the DLL must be shipped next to the
+// executable and ErrLoaderNotFound is returned if it is missing.
+func SetDiskOnly(enabled bool) {
+	diskOnlySync.Lock()
+	defer diskOnlySync.Unlock()
+	diskOnly = enabled
+}
+
+func isDiskOnly() bool {
+	diskOnlySync.Lock()
+	defer diskOnlySync.Unlock()
+	return diskOnly
 }
 
 //go:embed MicrosoftEdgeWebview2Setup.exe
@@ -191,38 +233,104 @@ func getKeyValue(k registry.Key, name string) string {
 	return result
 }
 
+var (
+	tempDir     string
+	tempDirSync sync.Mutex
+)
+
+// SetTempDir sets the directory the bootstrapper installer is extracted to.
+// An empty dir restores the default, os.TempDir(). This is needed where
+// policy locks down the user's temp folder.
+func SetTempDir(dir string) {
+	tempDirSync.Lock()
+	defer tempDirSync.Unlock()
+	tempDir = dir
+}
+
+// TempDir returns the directory set by SetTempDir or os.TempDir().
+func TempDir() string {
+	tempDirSync.Lock()
+	defer tempDirSync.Unlock()
+	if tempDir == "" {
+		return os.TempDir()
+	}
+	return tempDir
+}
+
+// DefaultInstallerTimeout is how long InstallUsingBootstrapper waits for the
+// bootstrapper before giving up.
+const DefaultInstallerTimeout = 10 * time.Minute
+
+// ErrInstallerTimeout is returned when the bootstrapper didn't finish in time,
+// for example because a UAC prompt was left unanswered.
+var ErrInstallerTimeout = errors.New("webviewloader: the WebView2 installer timed out")
+
+// installerAttempts is how often the bootstrapper is run when it fails, which
+// covers transient download errors.
+const installerAttempts = 2
+
+// errorCancelled is the exit code of the bootstrapper when the user declined
+// the UAC prompt. Retrying would only ask again.
+const errorCancelled = 0x800704C7
+
 // InstallUsingBootstrapper will extract the embedded bootstrapper from Microsoft and run it to install
-// the latest version of the runtime.
+// the latest version of the runtime, waiting at most DefaultInstallerTimeout.
 // Returns true if the installer ran successfully.
 // Returns an error if something goes wrong
 // 注意，此exe不支持arm64芯片
 func InstallUsingBootstrapper() (bool, error) {
-	exePath := filepath.Join(os.TempDir(), "MicrosoftEdgeWebview2Setup.exe")
+	ctx, cancel := context.WithTimeout(context.Background(), DefaultInstallerTimeout)
+	defer cancel()
+	return InstallUsingBootstrapperContext(ctx)
+}
+
+// InstallUsingBootstrapperContext is like InstallUsingBootstrapper but the
+// installer is killed when ctx is done. ErrInstallerTimeout is returned if the
+// deadline of ctx expired, ctx.Err() if it was cancelled.
+func InstallUsingBootstrapperContext(ctx context.Context) (bool, error) {
+	exePath := filepath.Join(TempDir(), "MicrosoftEdgeWebview2Setup.exe")
 	if err := ioutil.WriteFile(exePath, webview2setup, 0755); err != nil {
 		return false, err
 	}
+	defer os.Remove(exePath)
 
-	result, err := runInstaller(exePath)
-	if err != nil {
-		return false, err
+	var result bool
+	var err error
+	for attempt := 0; attempt < installerAttempts; attempt++ {
+		var exitCode uint32
+		result, exitCode, err = runInstaller(ctx, exePath)
+		if result || err != nil || exitCode == errorCancelled {
+			break
+		}
 	}
-
-	return result, os.Remove(exePath)
+	return result, err
 }
 
-func runInstaller(installer string) (bool, error) {
+// runInstaller runs the bootstrapper and maps its outcome: exit code 0 is
+// success, any other exit code is a failed install and is returned without an
+// error. Failing to start or wait for the process, and ctx finishing first,
+// are errors.
+func runInstaller(ctx context.Context, installer string) (bool, uint32, error) {
 	// Credit: https://stackoverflow.com/a/10385867
 	//cmd := exec.Command(installer)
-	cmd := exec.Command(installer, "/install") // 已安装时跳过(后台执行参数："/silent")
+	cmd := exec.CommandContext(ctx, installer, "/install") // 已安装时跳过(后台执行参数："/silent")
 	if err := cmd.Start(); err != nil {
-		return false, err
+		return false, 0, err
+	}
+	err := cmd.Wait()
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		if errors.Is(ctxErr, context.DeadlineExceeded) {
+			return false, 0, ErrInstallerTimeout
+		}
+		return false, 0, ctxErr
 	}
-	if err := cmd.Wait(); err != nil {
+	if err != nil {
 		if exitErr, ok := err.(*exec.ExitError); ok {
 			if status, ok := exitErr.Sys().(syscall.WaitStatus); ok {
-				return status.ExitStatus() == 0, nil
+				return false, status.ExitCode, nil
 			}
 		}
+		return false, 0, err
 	}
-	return true, nil
+	return true, 0, nil
 }